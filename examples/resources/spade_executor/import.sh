@@ -0,0 +1,5 @@
+# Executors can be imported by ID
+terraform import spade_executor.example 12
+
+# or by name
+terraform import spade_executor.example name:local
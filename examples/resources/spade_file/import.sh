@@ -0,0 +1,5 @@
+# Files can be imported by ID
+terraform import spade_file.example 12
+
+# or by code
+terraform import spade_file.example code:monthly_sales
@@ -0,0 +1,5 @@
+# File formats can be imported by ID
+terraform import spade_file_format.example 12
+
+# or by format
+terraform import spade_file_format.example format:csv
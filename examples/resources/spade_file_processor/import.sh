@@ -0,0 +1,5 @@
+# File processors can be imported by ID
+terraform import spade_file_processor.example 12
+
+# or by name
+terraform import spade_file_processor.example name:csv
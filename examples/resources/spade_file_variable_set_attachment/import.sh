@@ -0,0 +1,2 @@
+# Variable set attachments can be imported by file ID and variable set ID
+terraform import spade_file_variable_set_attachment.example 3/12
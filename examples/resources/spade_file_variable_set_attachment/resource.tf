@@ -0,0 +1,5 @@
+# Attaches a variable set to a file managed elsewhere
+resource "spade_file_variable_set_attachment" "my_attachment" {
+  file         = data.spade_file.shared.id
+  variable_set = spade_variable_set.my_variable_set.id
+}
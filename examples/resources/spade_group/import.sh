@@ -0,0 +1,5 @@
+# Groups can be imported by ID
+terraform import spade_group.example 12
+
+# or by name
+terraform import spade_group.example name:analysts
@@ -0,0 +1,3 @@
+# Group memberships can be imported by group ID followed by the
+# comma-separated IDs of the member users
+terraform import spade_group_membership.example 3/12,13
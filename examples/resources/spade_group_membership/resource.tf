@@ -0,0 +1,7 @@
+resource "spade_group_membership" "my_membership" {
+  group = spade_group.my_group.id
+  users = [
+    data.spade_user.ada.id,
+    data.spade_user.grace.id,
+  ]
+}
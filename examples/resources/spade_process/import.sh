@@ -0,0 +1,5 @@
+# Processes can be imported by ID
+terraform import spade_process.example 12
+
+# or by code
+terraform import spade_process.example code:daily_sales
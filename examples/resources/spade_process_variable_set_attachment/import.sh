@@ -0,0 +1,2 @@
+# Variable set attachments can be imported by process ID and variable set ID
+terraform import spade_process_variable_set_attachment.example 3/12
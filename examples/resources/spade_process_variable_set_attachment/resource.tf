@@ -0,0 +1,5 @@
+# Attaches a variable set to a process managed elsewhere
+resource "spade_process_variable_set_attachment" "my_attachment" {
+  process      = data.spade_process.shared.id
+  variable_set = spade_variable_set.my_variable_set.id
+}
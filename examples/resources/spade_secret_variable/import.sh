@@ -0,0 +1,5 @@
+# Secret variables can be imported by ID
+terraform import spade_secret_variable.example 12
+
+# or by name
+terraform import spade_secret_variable.example name:db_password
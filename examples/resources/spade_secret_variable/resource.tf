@@ -2,3 +2,11 @@ resource "spade_secret_variable" "my_secret" {
   name  = "my_secret"
   value = "super-secret-value"
 }
+
+# With Terraform 1.11 and later, the value can be kept out of the state.
+# Increment value_wo_version to update it.
+resource "spade_secret_variable" "my_write_only_secret" {
+  name             = "my_write_only_secret"
+  value_wo         = var.my_write_only_secret
+  value_wo_version = 1
+}
@@ -0,0 +1,5 @@
+# Users can be imported by ID
+terraform import spade_user.example 12
+
+# or by email
+terraform import spade_user.example email:ada@example.com
@@ -0,0 +1,5 @@
+# Variables can be imported by ID
+terraform import spade_variable.example 12
+
+# or by name
+terraform import spade_variable.example name:aws_region
@@ -2,3 +2,17 @@ resource "spade_variable" "my_variable" {
   name  = "my_variable"
   value = "my-value"
 }
+
+resource "spade_variable" "my_threshold" {
+  name         = "my_threshold"
+  value_number = 0.75
+}
+
+# JSON values are compacted, and formatting differences are not shown as changes
+resource "spade_variable" "my_mapping" {
+  name = "my_mapping"
+  value_json = jsonencode({
+    orders    = "sales.orders"
+    customers = "crm.customers"
+  })
+}
@@ -0,0 +1,5 @@
+# Variable sets can be imported by ID
+terraform import spade_variable_set.example 12
+
+# or by name
+terraform import spade_variable_set.example name:warehouse
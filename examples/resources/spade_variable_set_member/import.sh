@@ -0,0 +1,2 @@
+# Variable set members can be imported by variable set ID and variable ID
+terraform import spade_variable_set_member.example 3/12
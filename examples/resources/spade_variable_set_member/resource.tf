@@ -0,0 +1,5 @@
+# Adds a secret to a variable set managed elsewhere
+resource "spade_variable_set_member" "my_secret" {
+  variable_set = var.shared_variable_set_id
+  variable     = spade_secret_variable.my_secret.id
+}
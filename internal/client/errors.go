@@ -0,0 +1,98 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// SpadeAPIError is returned when the Spade API responds with a non-2xx status
+// code.
+type SpadeAPIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Operation is the action that failed, e.g. "create".
+	Operation string
+	// Resource is the kind of object the operation acted on, e.g. "file". It
+	// is empty for requests not tied to an object, such as login.
+	Resource string
+	// Body is the raw response body.
+	Body string
+	// FieldErrors holds the validation errors reported per field, parsed from
+	// a Django REST framework style body such as {"code": ["already exists"]}.
+	FieldErrors map[string][]string
+	// Messages holds errors not tied to a field, taken from the "detail" and
+	// "non_field_errors" keys.
+	Messages []string
+
+	retryAfter time.Duration
+}
+
+func (e *SpadeAPIError) Error() string {
+	operation := e.Operation
+	if e.Resource != "" {
+		operation += " " + e.Resource
+	}
+	if e.Body == "" {
+		return fmt.Sprintf("%s failed with status code %d", operation, e.StatusCode)
+	}
+	return fmt.Sprintf("%s failed with status code %d, response %s", operation, e.StatusCode, e.Body)
+}
+
+// newSpadeAPIError builds a SpadeAPIError, extracting field errors from body
+// when it is a JSON object.
+func newSpadeAPIError(operation, resource string, statusCode int, body []byte) *SpadeAPIError {
+	apiErr := &SpadeAPIError{
+		StatusCode: statusCode,
+		Operation:  operation,
+		Resource:   resource,
+		Body:       string(body),
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return apiErr
+	}
+	for field, raw := range fields {
+		messages := parseErrorMessages(raw)
+		if len(messages) == 0 {
+			continue
+		}
+		if field == "detail" || field == "non_field_errors" {
+			apiErr.Messages = append(apiErr.Messages, messages...)
+			continue
+		}
+		if apiErr.FieldErrors == nil {
+			apiErr.FieldErrors = map[string][]string{}
+		}
+		apiErr.FieldErrors[field] = messages
+	}
+	return apiErr
+}
+
+// parseErrorMessages decodes an error value, which DRF sends either as a
+// single string or a list of strings. Any other shape, such as errors for
+// nested objects, is returned as raw JSON.
+func parseErrorMessages(raw json.RawMessage) []string {
+	var message string
+	if err := json.Unmarshal(raw, &message); err == nil {
+		return []string{message}
+	}
+	var messages []string
+	if err := json.Unmarshal(raw, &messages); err == nil {
+		return messages
+	}
+	return []string{string(raw)}
+}
+
+// IsNotFound reports whether err is a 404 response from the Spade API.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+func hasStatus(err error, statusCode int) bool {
+	var apiErr *SpadeAPIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
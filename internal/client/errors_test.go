@@ -0,0 +1,49 @@
+package client
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestNewSpadeAPIError(t *testing.T) {
+	cases := []struct {
+		name        string
+		body        string
+		fieldErrors map[string][]string
+		messages    []string
+	}{
+		{name: "empty"},
+		{name: "not json", body: "<html>Bad Gateway</html>"},
+		{name: "detail", body: `{"detail": "Not found."}`, messages: []string{"Not found."}},
+		{
+			name:        "field errors",
+			body:        `{"name": ["already exists", "too long"], "value": "may not be blank"}`,
+			fieldErrors: map[string][]string{"name": {"already exists", "too long"}, "value": {"may not be blank"}},
+		},
+		{
+			name:     "non field errors",
+			body:     `{"non_field_errors": ["name and code must be unique together"]}`,
+			messages: []string{"name and code must be unique together"},
+		},
+		{
+			name:        "nested errors",
+			body:        `{"system_params": {"path": ["required"]}}`,
+			fieldErrors: map[string][]string{"system_params": {`{"path": ["required"]}`}},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := newSpadeAPIError("create", "file", http.StatusBadRequest, []byte(tc.body))
+			if err.Body != tc.body {
+				t.Errorf("expected body %q, got %q", tc.body, err.Body)
+			}
+			if !reflect.DeepEqual(err.FieldErrors, tc.fieldErrors) {
+				t.Errorf("expected field errors %v, got %v", tc.fieldErrors, err.FieldErrors)
+			}
+			if !reflect.DeepEqual(err.Messages, tc.messages) {
+				t.Errorf("expected messages %v, got %v", tc.messages, err.Messages)
+			}
+		})
+	}
+}
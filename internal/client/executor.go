@@ -1,12 +1,9 @@
 package client
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
-	"io"
 	"net/http"
-	"net/url"
 )
 
 type SpadeExecutorCreateRequest struct {
@@ -24,146 +21,56 @@ type SpadeExecutorReadResponse struct {
 	HistoryProviderCallable string `json:"history_provider_callable"`
 }
 
-func (c *SpadeClient) CreateExecutor(name, description, callable, historyProviderCallable string) (*SpadeExecutorReadResponse, error) {
-	httpReqBody, err := json.Marshal(SpadeExecutorCreateRequest{
+func (c *SpadeClient) CreateExecutor(ctx context.Context, name, description, callable, historyProviderCallable string) (*SpadeExecutorReadResponse, error) {
+	resp := SpadeExecutorReadResponse{}
+	err := c.do(ctx, "create", "executor", http.MethodPost, "/api/v1/executors", SpadeExecutorCreateRequest{
 		Name:                    name,
 		Description:             description,
 		Callable:                callable,
 		HistoryProviderCallable: historyProviderCallable,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	httpReq, err := http.NewRequest(
-		"POST",
-		c.ApiUrl+"/api/v1/executors",
-		bytes.NewBuffer(httpReqBody),
-	)
-	httpReq.Header.Set("Authorization", "Bearer "+c.Token)
-	httpReq.Header.Set("Content-Type", "application/json")
-	if err != nil {
-		return nil, err
-	}
-	httpResp, err := c.HttpClient.Do(httpReq)
-	if err != nil {
-		return nil, err
-	}
-	defer httpResp.Body.Close()
-	if !(httpResp.StatusCode >= 200 && httpResp.StatusCode < 300) {
-		bodyData, err := io.ReadAll(httpResp.Body)
-		if err != nil {
-			return nil, fmt.Errorf("create executor failed with status code %d", httpResp.StatusCode)
-		}
-		return nil, fmt.Errorf("create executor failed with status code %d, response %s", httpResp.StatusCode, string(bodyData))
-	}
-	resp := SpadeExecutorReadResponse{}
-	err = json.NewDecoder(httpResp.Body).Decode(&resp)
+	}, &resp)
 	if err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
-func (c *SpadeClient) ReadExecutor(id int64) (*SpadeExecutorReadResponse, error) {
-	httpReq, err := http.NewRequest(
-		"GET",
-		c.ApiUrl+"/api/v1/executors/"+fmt.Sprint(id),
-		nil,
-	)
-	httpReq.Header.Set("Authorization", "Bearer "+c.Token)
-	httpReq.Header.Set("Content-Type", "application/json")
-	if err != nil {
-		return nil, err
-	}
-	httpResp, err := c.HttpClient.Do(httpReq)
-	if err != nil {
-		return nil, err
-	}
-	defer httpResp.Body.Close()
-	if httpResp.StatusCode == 404 {
+func (c *SpadeClient) ReadExecutor(ctx context.Context, id int64) (*SpadeExecutorReadResponse, error) {
+	resp := SpadeExecutorReadResponse{}
+	err := c.do(ctx, "read", "executor", http.MethodGet, "/api/v1/executors/"+fmt.Sprint(id), nil, &resp)
+	if IsNotFound(err) {
 		return nil, nil
 	}
-	if !(httpResp.StatusCode >= 200 && httpResp.StatusCode < 300) {
-		bodyData, err := io.ReadAll(httpResp.Body)
-		if err != nil {
-			return nil, fmt.Errorf("read executor failed with status code %d", httpResp.StatusCode)
-		}
-		return nil, fmt.Errorf("read executor failed with status code %d, response %s", httpResp.StatusCode, string(bodyData))
-	}
-	resp := SpadeExecutorReadResponse{}
-	err = json.NewDecoder(httpResp.Body).Decode(&resp)
 	if err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
-func (c *SpadeClient) UpdateExecutor(id int64, name, description, callable, historyProviderCallable string) (*SpadeExecutorReadResponse, error) {
-	httpReqBody, err := json.Marshal(SpadeExecutorCreateRequest{
+func (c *SpadeClient) UpdateExecutor(ctx context.Context, id int64, name, description, callable, historyProviderCallable string) (*SpadeExecutorReadResponse, error) {
+	resp := SpadeExecutorReadResponse{}
+	err := c.do(ctx, "update", "executor", http.MethodPatch, "/api/v1/executors/"+fmt.Sprint(id), SpadeExecutorCreateRequest{
 		Name:                    name,
 		Description:             description,
 		Callable:                callable,
 		HistoryProviderCallable: historyProviderCallable,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	httpReq, err := http.NewRequest(
-		"PATCH",
-		c.ApiUrl+"/api/v1/executors/"+fmt.Sprint(id),
-		bytes.NewBuffer(httpReqBody),
-	)
-	httpReq.Header.Set("Authorization", "Bearer "+c.Token)
-	httpReq.Header.Set("Content-Type", "application/json")
-	if err != nil {
-		return nil, err
-	}
-	httpResp, err := c.HttpClient.Do(httpReq)
-	if err != nil {
-		return nil, err
-	}
-	defer httpResp.Body.Close()
-	if !(httpResp.StatusCode >= 200 && httpResp.StatusCode < 300) {
-		bodyData, err := io.ReadAll(httpResp.Body)
-		if err != nil {
-			return nil, fmt.Errorf("update executor failed with status code %d", httpResp.StatusCode)
-		}
-		return nil, fmt.Errorf("update executor failed with status code %d, response %s", httpResp.StatusCode, string(bodyData))
-	}
-	resp := SpadeExecutorReadResponse{}
-	err = json.NewDecoder(httpResp.Body).Decode(&resp)
+	}, &resp)
 	if err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
-func (c *SpadeClient) DeleteExecutor(id int64) error {
-	url, err := url.Parse(c.ApiUrl + "/api/v1/executors/" + fmt.Sprint(id))
-	if err != nil {
-		return err
-	}
-	httpReq := &http.Request{
-		Method: "DELETE",
-		URL:    url,
-		Header: map[string][]string{
-			"Authorization": {"Bearer " + c.Token},
-			"Content-Type":  {"application/json"},
-		},
-	}
-	httpResp, err := c.HttpClient.Do(httpReq)
-	if err != nil {
-		return err
-	}
-	defer httpResp.Body.Close()
-	if !(httpResp.StatusCode >= 200 && httpResp.StatusCode < 300) {
-		bodyData, err := io.ReadAll(httpResp.Body)
-		if err != nil {
-			return fmt.Errorf("delete executor failed with status code %d", httpResp.StatusCode)
-		}
-		return fmt.Errorf("delete executor failed with status code %d, response %s", httpResp.StatusCode, string(bodyData))
-	}
-	return nil
+func (c *SpadeClient) DeleteExecutor(ctx context.Context, id int64) error {
+	return c.do(ctx, "delete", "executor", http.MethodDelete, "/api/v1/executors/"+fmt.Sprint(id), nil, nil)
+}
+
+func (c *SpadeClient) SearchExecutor(ctx context.Context, name string) (*SpadeExecutorReadResponse, error) {
+	return search(ctx, c, "executor", "/api/v1/executors", "name", name, func(v SpadeExecutorReadResponse) string {
+		return v.Name
+	})
+}
+
+func (c *SpadeClient) ListExecutors(ctx context.Context) ([]SpadeExecutorReadResponse, error) {
+	return list[SpadeExecutorReadResponse](ctx, c, "list", "executors", "/api/v1/executors")
 }
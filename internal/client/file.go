@@ -1,12 +1,9 @@
 package client
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
-	"io"
 	"net/http"
-	"net/url"
 )
 
 type SpadeFileCreateRequest struct {
@@ -21,6 +18,10 @@ type SpadeFileCreateRequest struct {
 	VariableSets  []int64                `json:"variable_sets"`
 }
 
+type SpadeFileVariableSetsUpdateRequest struct {
+	VariableSets []int64 `json:"variable_sets"`
+}
+
 type SpadeFileReadResponse struct {
 	Id            int64                  `json:"id"`
 	Code          string                 `json:"code"`
@@ -34,12 +35,13 @@ type SpadeFileReadResponse struct {
 	VariableSets  []int64                `json:"variable_sets"`
 }
 
-func (c *SpadeClient) CreateFile(code, description string, tags []string, format, processor int64, systemParams, userParams map[string]interface{}, linkedProcess int64, variableSets []int64) (*SpadeFileReadResponse, error) {
+func (c *SpadeClient) CreateFile(ctx context.Context, code, description string, tags []string, format, processor int64, systemParams, userParams map[string]interface{}, linkedProcess int64, variableSets []int64) (*SpadeFileReadResponse, error) {
 	linkedProcessPtr := &linkedProcess
 	if linkedProcess == 0 {
 		linkedProcessPtr = nil
 	}
-	httpReqBody, err := json.Marshal(SpadeFileCreateRequest{
+	resp := SpadeFileReadResponse{}
+	err := c.do(ctx, "create", "file", http.MethodPost, "/api/v1/files", SpadeFileCreateRequest{
 		Code:          code,
 		Description:   description,
 		Tags:          tags,
@@ -49,81 +51,32 @@ func (c *SpadeClient) CreateFile(code, description string, tags []string, format
 		UserParams:    userParams,
 		LinkedProcess: linkedProcessPtr,
 		VariableSets:  variableSets,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	httpReq, err := http.NewRequest(
-		"POST",
-		c.ApiUrl+"/api/v1/files",
-		bytes.NewBuffer(httpReqBody),
-	)
-	httpReq.Header.Set("Authorization", "Bearer "+c.Token)
-	httpReq.Header.Set("Content-Type", "application/json")
-	if err != nil {
-		return nil, err
-	}
-	httpResp, err := c.HttpClient.Do(httpReq)
-	if err != nil {
-		return nil, err
-	}
-	defer httpResp.Body.Close()
-	if !(httpResp.StatusCode >= 200 && httpResp.StatusCode < 300) {
-		bodyData, err := io.ReadAll(httpResp.Body)
-		if err != nil {
-			return nil, fmt.Errorf("create file failed with status code %d", httpResp.StatusCode)
-		}
-		return nil, fmt.Errorf("create file failed with status code %d, response %s", httpResp.StatusCode, string(bodyData))
-	}
-	resp := SpadeFileReadResponse{}
-	err = json.NewDecoder(httpResp.Body).Decode(&resp)
+	}, &resp)
 	if err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
-func (c *SpadeClient) ReadFile(id int64) (*SpadeFileReadResponse, error) {
-	httpReq, err := http.NewRequest(
-		"GET",
-		c.ApiUrl+"/api/v1/files/"+fmt.Sprint(id),
-		nil,
-	)
-	httpReq.Header.Set("Authorization", "Bearer "+c.Token)
-	httpReq.Header.Set("Content-Type", "application/json")
-	if err != nil {
-		return nil, err
-	}
-	httpResp, err := c.HttpClient.Do(httpReq)
-	if err != nil {
-		return nil, err
-	}
-	defer httpResp.Body.Close()
-	if httpResp.StatusCode == 404 {
+func (c *SpadeClient) ReadFile(ctx context.Context, id int64) (*SpadeFileReadResponse, error) {
+	resp := SpadeFileReadResponse{}
+	err := c.do(ctx, "read", "file", http.MethodGet, "/api/v1/files/"+fmt.Sprint(id), nil, &resp)
+	if IsNotFound(err) {
 		return nil, nil
 	}
-	if !(httpResp.StatusCode >= 200 && httpResp.StatusCode < 300) {
-		bodyData, err := io.ReadAll(httpResp.Body)
-		if err != nil {
-			return nil, fmt.Errorf("read file failed with status code %d", httpResp.StatusCode)
-		}
-		return nil, fmt.Errorf("read file failed with status code %d, response %s", httpResp.StatusCode, string(bodyData))
-	}
-	resp := SpadeFileReadResponse{}
-	err = json.NewDecoder(httpResp.Body).Decode(&resp)
 	if err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
-func (c *SpadeClient) UpdateFile(id int64, code, description string, tags []string, format, processor int64, systemParams, userParams map[string]interface{}, linkedProcess int64, variableSets []int64) (*SpadeFileReadResponse, error) {
+func (c *SpadeClient) UpdateFile(ctx context.Context, id int64, code, description string, tags []string, format, processor int64, systemParams, userParams map[string]interface{}, linkedProcess int64, variableSets []int64) (*SpadeFileReadResponse, error) {
 	linkedProcessPtr := &linkedProcess
 	if linkedProcess == 0 {
 		linkedProcessPtr = nil
 	}
-	httpReqBody, err := json.Marshal(SpadeFileCreateRequest{
+	resp := SpadeFileReadResponse{}
+	err := c.do(ctx, "update", "file", http.MethodPatch, "/api/v1/files/"+fmt.Sprint(id), SpadeFileCreateRequest{
 		Code:          code,
 		Description:   description,
 		Tags:          tags,
@@ -133,65 +86,39 @@ func (c *SpadeClient) UpdateFile(id int64, code, description string, tags []stri
 		UserParams:    userParams,
 		LinkedProcess: linkedProcessPtr,
 		VariableSets:  variableSets,
-	})
+	}, &resp)
 	if err != nil {
 		return nil, err
 	}
+	return &resp, nil
+}
 
-	httpReq, err := http.NewRequest(
-		"PATCH",
-		c.ApiUrl+"/api/v1/files/"+fmt.Sprint(id),
-		bytes.NewBuffer(httpReqBody),
-	)
-	httpReq.Header.Set("Authorization", "Bearer "+c.Token)
-	httpReq.Header.Set("Content-Type", "application/json")
-	if err != nil {
-		return nil, err
-	}
-	httpResp, err := c.HttpClient.Do(httpReq)
-	if err != nil {
-		return nil, err
-	}
-	defer httpResp.Body.Close()
-	if !(httpResp.StatusCode >= 200 && httpResp.StatusCode < 300) {
-		bodyData, err := io.ReadAll(httpResp.Body)
-		if err != nil {
-			return nil, fmt.Errorf("update file failed with status code %d", httpResp.StatusCode)
-		}
-		return nil, fmt.Errorf("update file failed with status code %d, response %s", httpResp.StatusCode, string(bodyData))
+// UpdateFileVariableSets replaces the variable sets of a file, leaving its
+// other fields untouched.
+func (c *SpadeClient) UpdateFileVariableSets(ctx context.Context, id int64, variableSets []int64) (*SpadeFileReadResponse, error) {
+	if variableSets == nil {
+		variableSets = []int64{}
 	}
 	resp := SpadeFileReadResponse{}
-	err = json.NewDecoder(httpResp.Body).Decode(&resp)
+	err := c.do(ctx, "update", "file", http.MethodPatch, "/api/v1/files/"+fmt.Sprint(id), SpadeFileVariableSetsUpdateRequest{
+		VariableSets: variableSets,
+	}, &resp)
 	if err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
-func (c *SpadeClient) DeleteFile(id int64) error {
-	url, err := url.Parse(c.ApiUrl + "/api/v1/files/" + fmt.Sprint(id))
-	if err != nil {
-		return err
-	}
-	httpReq := &http.Request{
-		Method: "DELETE",
-		URL:    url,
-		Header: map[string][]string{
-			"Authorization": {"Bearer " + c.Token},
-			"Content-Type":  {"application/json"},
-		},
-	}
-	httpResp, err := c.HttpClient.Do(httpReq)
-	if err != nil {
-		return err
-	}
-	defer httpResp.Body.Close()
-	if !(httpResp.StatusCode >= 200 && httpResp.StatusCode < 300) {
-		bodyData, err := io.ReadAll(httpResp.Body)
-		if err != nil {
-			return fmt.Errorf("delete file failed with status code %d", httpResp.StatusCode)
-		}
-		return fmt.Errorf("delete file failed with status code %d, response %s", httpResp.StatusCode, string(bodyData))
-	}
-	return nil
+func (c *SpadeClient) DeleteFile(ctx context.Context, id int64) error {
+	return c.do(ctx, "delete", "file", http.MethodDelete, "/api/v1/files/"+fmt.Sprint(id), nil, nil)
+}
+
+func (c *SpadeClient) SearchFile(ctx context.Context, code string) (*SpadeFileReadResponse, error) {
+	return search(ctx, c, "file", "/api/v1/files", "code", code, func(v SpadeFileReadResponse) string {
+		return v.Code
+	})
+}
+
+func (c *SpadeClient) ListFiles(ctx context.Context) ([]SpadeFileReadResponse, error) {
+	return list[SpadeFileReadResponse](ctx, c, "list", "files", "/api/v1/files")
 }
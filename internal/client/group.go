@@ -1,12 +1,9 @@
 package client
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
-	"io"
 	"net/http"
-	"net/url"
 )
 
 type SpadeGroupCreateRequest struct {
@@ -18,141 +15,46 @@ type SpadeGroupReadResponse struct {
 	Name string `json:"name"`
 }
 
-func (c *SpadeClient) CreateGroup(name string) (*SpadeGroupReadResponse, error) {
-	httpReqBody, err := json.Marshal(SpadeGroupCreateRequest{
-		Name: name,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	httpReq, err := http.NewRequest(
-		"POST",
-		c.ApiUrl+"/api/v1/groups",
-		bytes.NewBuffer(httpReqBody),
-	)
-	httpReq.Header.Set("Authorization", "Bearer "+c.Token)
-	httpReq.Header.Set("Content-Type", "application/json")
-	if err != nil {
-		return nil, err
-	}
-	httpResp, err := c.HttpClient.Do(httpReq)
-	if err != nil {
-		return nil, err
-	}
-	defer httpResp.Body.Close()
-	if !(httpResp.StatusCode >= 200 && httpResp.StatusCode < 300) {
-		bodyData, err := io.ReadAll(httpResp.Body)
-		if err != nil {
-			return nil, fmt.Errorf("create group failed with status code %d", httpResp.StatusCode)
-		}
-		return nil, fmt.Errorf("create group failed with status code %d, response %s", httpResp.StatusCode, string(bodyData))
-	}
+func (c *SpadeClient) CreateGroup(ctx context.Context, name string) (*SpadeGroupReadResponse, error) {
 	resp := SpadeGroupReadResponse{}
-	err = json.NewDecoder(httpResp.Body).Decode(&resp)
+	err := c.do(ctx, "create", "group", http.MethodPost, "/api/v1/groups", SpadeGroupCreateRequest{
+		Name: name,
+	}, &resp)
 	if err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
-func (c *SpadeClient) ReadGroup(id int64) (*SpadeGroupReadResponse, error) {
-	httpReq, err := http.NewRequest(
-		"GET",
-		c.ApiUrl+"/api/v1/groups/"+fmt.Sprint(id),
-		nil,
-	)
-	httpReq.Header.Set("Authorization", "Bearer "+c.Token)
-	httpReq.Header.Set("Content-Type", "application/json")
-	if err != nil {
-		return nil, err
-	}
-	httpResp, err := c.HttpClient.Do(httpReq)
-	if err != nil {
-		return nil, err
-	}
-	defer httpResp.Body.Close()
-	if httpResp.StatusCode == 404 {
+func (c *SpadeClient) ReadGroup(ctx context.Context, id int64) (*SpadeGroupReadResponse, error) {
+	resp := SpadeGroupReadResponse{}
+	err := c.do(ctx, "read", "group", http.MethodGet, "/api/v1/groups/"+fmt.Sprint(id), nil, &resp)
+	if IsNotFound(err) {
 		return nil, nil
 	}
-	if !(httpResp.StatusCode >= 200 && httpResp.StatusCode < 300) {
-		bodyData, err := io.ReadAll(httpResp.Body)
-		if err != nil {
-			return nil, fmt.Errorf("read group failed with status code %d", httpResp.StatusCode)
-		}
-		return nil, fmt.Errorf("read group failed with status code %d, response %s", httpResp.StatusCode, string(bodyData))
-	}
-	resp := SpadeGroupReadResponse{}
-	err = json.NewDecoder(httpResp.Body).Decode(&resp)
 	if err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
-func (c *SpadeClient) UpdateGroup(id int64, name string) (*SpadeGroupReadResponse, error) {
-
-	httpReqBody, err := json.Marshal(SpadeGroupCreateRequest{
-		Name: name,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	httpReq, err := http.NewRequest(
-		"PATCH",
-		c.ApiUrl+"/api/v1/groups/"+fmt.Sprint(id),
-		bytes.NewBuffer(httpReqBody),
-	)
-	httpReq.Header.Set("Authorization", "Bearer "+c.Token)
-	httpReq.Header.Set("Content-Type", "application/json")
-	if err != nil {
-		return nil, err
-	}
-	httpResp, err := c.HttpClient.Do(httpReq)
-	if err != nil {
-		return nil, err
-	}
-	defer httpResp.Body.Close()
-	if !(httpResp.StatusCode >= 200 && httpResp.StatusCode < 300) {
-		bodyData, err := io.ReadAll(httpResp.Body)
-		if err != nil {
-			return nil, fmt.Errorf("update group failed with status code %d", httpResp.StatusCode)
-		}
-		return nil, fmt.Errorf("update group failed with status code %d, response %s", httpResp.StatusCode, string(bodyData))
-	}
+func (c *SpadeClient) UpdateGroup(ctx context.Context, id int64, name string) (*SpadeGroupReadResponse, error) {
 	resp := SpadeGroupReadResponse{}
-	err = json.NewDecoder(httpResp.Body).Decode(&resp)
+	err := c.do(ctx, "update", "group", http.MethodPatch, "/api/v1/groups/"+fmt.Sprint(id), SpadeGroupCreateRequest{
+		Name: name,
+	}, &resp)
 	if err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
-func (c *SpadeClient) DeleteGroup(id int64) error {
-	url, err := url.Parse(c.ApiUrl + "/api/v1/groups/" + fmt.Sprint(id))
-	if err != nil {
-		return err
-	}
-	httpReq := &http.Request{
-		Method: "DELETE",
-		URL:    url,
-		Header: map[string][]string{
-			"Authorization": {"Bearer " + c.Token},
-			"Content-Type":  {"application/json"},
-		},
-	}
-	httpResp, err := c.HttpClient.Do(httpReq)
-	if err != nil {
-		return err
-	}
-	defer httpResp.Body.Close()
-	if !(httpResp.StatusCode >= 200 && httpResp.StatusCode < 300) {
-		bodyData, err := io.ReadAll(httpResp.Body)
-		if err != nil {
-			return fmt.Errorf("delete group failed with status code %d", httpResp.StatusCode)
-		}
-		return fmt.Errorf("delete group failed with status code %d, response %s", httpResp.StatusCode, string(bodyData))
-	}
-	return nil
+func (c *SpadeClient) DeleteGroup(ctx context.Context, id int64) error {
+	return c.do(ctx, "delete", "group", http.MethodDelete, "/api/v1/groups/"+fmt.Sprint(id), nil, nil)
+}
+
+func (c *SpadeClient) SearchGroup(ctx context.Context, name string) (*SpadeGroupReadResponse, error) {
+	return search(ctx, c, "group", "/api/v1/groups", "name", name, func(v SpadeGroupReadResponse) string {
+		return v.Name
+	})
 }
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// sensitiveKeys are the JSON keys whose values are masked in debug logs.
+var sensitiveKeys = map[string]bool{
+	"password": true,
+	"access":   true,
+	"refresh":  true,
+	"token":    true,
+}
+
+// maskSensitiveValues returns a context in which the sensitive values found
+// in the JSON body are masked from all log output: passwords, tokens and the
+// value of any object flagged with is_secret.
+func maskSensitiveValues(ctx context.Context, body []byte) context.Context {
+	var decoded interface{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return ctx
+	}
+	var values []string
+	collectSensitiveValues(decoded, &values)
+	if len(values) == 0 {
+		return ctx
+	}
+	return tflog.MaskLogStrings(ctx, values...)
+}
+
+func collectSensitiveValues(decoded interface{}, values *[]string) {
+	switch decoded := decoded.(type) {
+	case map[string]interface{}:
+		isSecret, _ := decoded["is_secret"].(bool)
+		for key, value := range decoded {
+			if str, ok := value.(string); ok && str != "" && (sensitiveKeys[key] || (isSecret && key == "value")) {
+				*values = append(*values, str)
+				continue
+			}
+			collectSensitiveValues(value, values)
+		}
+	case []interface{}:
+		for _, item := range decoded {
+			collectSensitiveValues(item, values)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+
+	"terraform-provider-spade/internal/spadetest"
+)
+
+func TestLogsMaskSensitiveValues(t *testing.T) {
+	server := spadetest.NewServer()
+	defer server.Close()
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	c := &SpadeClient{ApiUrl: server.URL, HttpClient: server.Client()}
+	if err := c.Login(ctx, server.Email, server.Password); err != nil {
+		t.Fatalf("login failed: %s", err)
+	}
+	secrets := []string{server.Password, c.Token, c.RefreshToken}
+
+	variable, err := c.CreateVariable(ctx, "tf_acc_secret", "", "hunter2", true)
+	if err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+	if _, err := c.ReadVariable(ctx, variable.Id); err != nil {
+		t.Fatalf("read failed: %s", err)
+	}
+	if _, err := c.UpdateVariable(ctx, variable.Id, variable.Name, "", "hunter3", true); err != nil {
+		t.Fatalf("update failed: %s", err)
+	}
+	if _, err := c.reauthenticate(ctx, c.Token); err != nil {
+		t.Fatalf("refresh failed: %s", err)
+	}
+	secrets = append(secrets, "hunter2", "hunter3", c.Token, c.RefreshToken)
+
+	logs := output.String()
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unable to decode logs: %s", err)
+	}
+	// login, create, read, update and refresh
+	if len(entries) != 5 {
+		t.Fatalf("expected a log entry per request, got %d: %s", len(entries), logs)
+	}
+	for _, secret := range secrets {
+		if strings.Contains(logs, secret) {
+			t.Errorf("expected %q to be masked in logs: %s", secret, logs)
+		}
+	}
+}
+
+func TestCollectSensitiveValues(t *testing.T) {
+	body := `{
+		"email": "admin@example.com",
+		"password": "hunter2",
+		"results": [
+			{"name": "region", "value": "eu-west-1", "is_secret": false},
+			{"name": "password", "value": "s3cr3t", "is_secret": true}
+		]
+	}`
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(body), &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	var values []string
+	collectSensitiveValues(decoded, &values)
+	sort.Strings(values)
+	if !slices.Equal(values, []string{"hunter2", "s3cr3t"}) {
+		t.Errorf("expected the password and secret value to be collected, got %v", values)
+	}
+}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// maxPages bounds the number of pages fetched by list, in case the server
+// keeps returning next links.
+const maxPages = 1000
+
+// spadePage is a single page of a paginated list response.
+type spadePage[T any] struct {
+	Count   int     `json:"count"`
+	Next    *string `json:"next"`
+	Results []T     `json:"results"`
+}
+
+// list fetches every item of a list endpoint, following the next link of each
+// page until the last one. Endpoints with pagination disabled, which return a
+// bare JSON array, are supported as well.
+func list[T any](ctx context.Context, c *SpadeClient, operation, resource, path string) ([]T, error) {
+	var items []T
+	for pages := 0; path != ""; pages++ {
+		if pages >= maxPages {
+			return nil, fmt.Errorf("%s %s failed: more than %d pages returned", operation, resource, maxPages)
+		}
+
+		var raw json.RawMessage
+		err := c.do(ctx, operation, resource, http.MethodGet, path, nil, &raw)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.HasPrefix(bytes.TrimSpace(raw), []byte("[")) {
+			var results []T
+			if err := json.Unmarshal(raw, &results); err != nil {
+				return nil, err
+			}
+			return append(items, results...), nil
+		}
+
+		page := spadePage[T]{}
+		if err := json.Unmarshal(raw, &page); err != nil {
+			return nil, err
+		}
+		items = append(items, page.Results...)
+
+		path = ""
+		if page.Next != nil && *page.Next != "" {
+			path, err = c.nextPagePath(*page.Next)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return items, nil
+}
+
+// nextPagePath converts the next link of a page into a path relative to
+// ApiUrl. The scheme and host of the link are ignored, as Spade running behind
+// a proxy may report its internal address.
+func (c *SpadeClient) nextPagePath(next string) (string, error) {
+	nextUrl, err := url.Parse(next)
+	if err != nil {
+		return "", fmt.Errorf("invalid next page link %q: %w", next, err)
+	}
+	apiUrl, err := url.Parse(c.ApiUrl)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimPrefix(nextUrl.RequestURI(), strings.TrimSuffix(apiUrl.Path, "/")), nil
+}
+
+// search returns the item whose field equals value among the results of a
+// search query on path. The search parameter matches partially and on several
+// fields, so results are filtered using key to find the exact match.
+func search[T any](ctx context.Context, c *SpadeClient, resource, path, field, value string, key func(T) string) (*T, error) {
+	results, err := list[T](ctx, c, "search", resource, path+"?search="+url.QueryEscape(value))
+	if err != nil {
+		return nil, err
+	}
+	for i := range results {
+		if key(results[i]) == value {
+			return &results[i], nil
+		}
+	}
+	return nil, fmt.Errorf("cannot find %s with %s: %s", resource, field, value)
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// newPagesServer starts a server answering the executors list endpoint with
+// the given handler, mounted under /spade as if behind a proxy.
+func newPagesServer(t *testing.T, handler func(w http.ResponseWriter, r *http.Request)) *SpadeClient {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/spade/api/v1/executors", handler)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return &SpadeClient{ApiUrl: server.URL + "/spade", HttpClient: server.Client(), Token: "token"}
+}
+
+func TestListFollowsPages(t *testing.T) {
+	c := newPagesServer(t, func(w http.ResponseWriter, r *http.Request) {
+		// next links point at the internal address of Spade
+		switch r.URL.Query().Get("page") {
+		case "":
+			fmt.Fprint(w, `{"count": 3, "next": "http://spade.internal:8000/spade/api/v1/executors?page=2", "results": [{"id": 1}, {"id": 2}]}`)
+		case "2":
+			fmt.Fprint(w, `{"count": 3, "next": null, "results": [{"id": 3}]}`)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	executors, err := c.ListExecutors(context.Background())
+	if err != nil {
+		t.Fatalf("list failed: %s", err)
+	}
+	if len(executors) != 3 || executors[2].Id != 3 {
+		t.Errorf("expected the executors of both pages, got %v", executors)
+	}
+}
+
+func TestListUnpaginated(t *testing.T) {
+	c := newPagesServer(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": 1}, {"id": 2}]`)
+	})
+
+	executors, err := c.ListExecutors(context.Background())
+	if err != nil {
+		t.Fatalf("list failed: %s", err)
+	}
+	if len(executors) != 2 {
+		t.Errorf("expected both executors, got %v", executors)
+	}
+}
+
+func TestListPageLimit(t *testing.T) {
+	var requests atomic.Int64
+	c := newPagesServer(t, func(w http.ResponseWriter, r *http.Request) {
+		page := requests.Add(1) + 1
+		fmt.Fprintf(w, `{"count": 1, "next": "http://%s/spade/api/v1/executors?page=%d", "results": []}`, r.Host, page)
+	})
+
+	_, err := c.ListExecutors(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "more than") {
+		t.Fatalf("expected the page limit to be reached, got error: %v", err)
+	}
+	if requests.Load() != maxPages {
+		t.Errorf("expected %d pages to be fetched, got %d", maxPages, requests.Load())
+	}
+}
+
+func TestNextPagePath(t *testing.T) {
+	cases := []struct {
+		apiUrl string
+		next   string
+		path   string
+	}{
+		{"https://spade.example.com", "https://spade.example.com/api/v1/files?page=2", "/api/v1/files?page=2"},
+		{"https://spade.example.com", "http://10.0.0.1:8000/api/v1/files?page=2&search=a+b", "/api/v1/files?page=2&search=a+b"},
+		{"https://example.com/spade/", "http://spade:8000/spade/api/v1/files?page=3", "/api/v1/files?page=3"},
+	}
+	for _, tc := range cases {
+		c := &SpadeClient{ApiUrl: tc.apiUrl}
+		path, err := c.nextPagePath(tc.next)
+		if err != nil {
+			t.Errorf("nextPagePath(%q) failed: %s", tc.next, err)
+			continue
+		}
+		if path != tc.path {
+			t.Errorf("nextPagePath(%q) = %q, expected %q", tc.next, path, tc.path)
+		}
+	}
+
+	c := &SpadeClient{ApiUrl: "https://spade.example.com"}
+	if _, err := c.nextPagePath("http://[::1"); err == nil {
+		t.Error("expected an error for an invalid next link")
+	}
+}
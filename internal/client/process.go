@@ -1,12 +1,9 @@
 package client
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
-	"io"
 	"net/http"
-	"net/url"
 )
 
 type SpadeProcessCreateRequest struct {
@@ -19,6 +16,10 @@ type SpadeProcessCreateRequest struct {
 	VariableSets []int64                `json:"variable_sets"`
 }
 
+type SpadeProcessVariableSetsUpdateRequest struct {
+	VariableSets []int64 `json:"variable_sets"`
+}
+
 type SpadeProcessReadResponse struct {
 	Id           int64                  `json:"id"`
 	Code         string                 `json:"code"`
@@ -30,8 +31,9 @@ type SpadeProcessReadResponse struct {
 	VariableSets []int64                `json:"variable_sets"`
 }
 
-func (c *SpadeClient) CreateProcess(code, description string, tags []string, executor int64, systemParams, userParams map[string]interface{}, variableSets []int64) (*SpadeProcessReadResponse, error) {
-	httpReqBody, err := json.Marshal(SpadeProcessCreateRequest{
+func (c *SpadeClient) CreateProcess(ctx context.Context, code, description string, tags []string, executor int64, systemParams, userParams map[string]interface{}, variableSets []int64) (*SpadeProcessReadResponse, error) {
+	resp := SpadeProcessReadResponse{}
+	err := c.do(ctx, "create", "process", http.MethodPost, "/api/v1/processes", SpadeProcessCreateRequest{
 		Code:         code,
 		Description:  description,
 		Tags:         tags,
@@ -39,72 +41,28 @@ func (c *SpadeClient) CreateProcess(code, description string, tags []string, exe
 		SystemParams: systemParams,
 		UserParams:   userParams,
 		VariableSets: variableSets,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	httpReq, err := http.NewRequest(
-		"POST",
-		c.ApiUrl+"/api/v1/processes",
-		bytes.NewBuffer(httpReqBody),
-	)
-	httpReq.Header.Set("Authorization", "Bearer "+c.Token)
-	httpReq.Header.Set("Content-Type", "application/json")
-	if err != nil {
-		return nil, err
-	}
-	httpResp, err := c.HttpClient.Do(httpReq)
-	if err != nil {
-		return nil, err
-	}
-	defer httpResp.Body.Close()
-	resp := SpadeProcessReadResponse{}
-	err = json.NewDecoder(httpResp.Body).Decode(&resp)
+	}, &resp)
 	if err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
-func (c *SpadeClient) ReadProcess(id int64) (*SpadeProcessReadResponse, error) {
-	httpReq, err := http.NewRequest(
-		"GET",
-		c.ApiUrl+"/api/v1/processes/"+fmt.Sprint(id),
-		nil,
-	)
-	httpReq.Header.Set("Authorization", "Bearer "+c.Token)
-	httpReq.Header.Set("Content-Type", "application/json")
-	if err != nil {
-		return nil, err
-	}
-
-	httpResp, err := c.HttpClient.Do(httpReq)
-	if err != nil {
-		return nil, err
-	}
-	defer httpResp.Body.Close()
-	if httpResp.StatusCode == 404 {
+func (c *SpadeClient) ReadProcess(ctx context.Context, id int64) (*SpadeProcessReadResponse, error) {
+	resp := SpadeProcessReadResponse{}
+	err := c.do(ctx, "read", "process", http.MethodGet, "/api/v1/processes/"+fmt.Sprint(id), nil, &resp)
+	if IsNotFound(err) {
 		return nil, nil
 	}
-	if !(httpResp.StatusCode >= 200 && httpResp.StatusCode < 300) {
-		bodyData, err := io.ReadAll(httpResp.Body)
-		if err != nil {
-			return nil, fmt.Errorf("read process failed with status code %d", httpResp.StatusCode)
-		}
-		return nil, fmt.Errorf("read process failed with status code %d, response %s", httpResp.StatusCode, string(bodyData))
-	}
-
-	resp := SpadeProcessReadResponse{}
-	err = json.NewDecoder(httpResp.Body).Decode(&resp)
 	if err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
-func (c *SpadeClient) UpdateProcess(id int64, code, description string, tags []string, executor int64, systemParams, userParams map[string]interface{}, variableSets []int64) (*SpadeProcessReadResponse, error) {
-	httpReqBody, err := json.Marshal(SpadeProcessCreateRequest{
+func (c *SpadeClient) UpdateProcess(ctx context.Context, id int64, code, description string, tags []string, executor int64, systemParams, userParams map[string]interface{}, variableSets []int64) (*SpadeProcessReadResponse, error) {
+	resp := SpadeProcessReadResponse{}
+	err := c.do(ctx, "update", "process", http.MethodPatch, "/api/v1/processes/"+fmt.Sprint(id), SpadeProcessCreateRequest{
 		Code:         code,
 		Description:  description,
 		Tags:         tags,
@@ -112,53 +70,39 @@ func (c *SpadeClient) UpdateProcess(id int64, code, description string, tags []s
 		SystemParams: systemParams,
 		UserParams:   userParams,
 		VariableSets: variableSets,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	httpReq, err := http.NewRequest(
-		"PATCH",
-		c.ApiUrl+"/api/v1/processes/"+fmt.Sprint(id),
-		bytes.NewBuffer(httpReqBody),
-	)
-	httpReq.Header.Set("Authorization", "Bearer "+c.Token)
-	httpReq.Header.Set("Content-Type", "application/json")
+	}, &resp)
 	if err != nil {
 		return nil, err
 	}
+	return &resp, nil
+}
 
-	httpResp, err := c.HttpClient.Do(httpReq)
-	if err != nil {
-		return nil, err
+// UpdateProcessVariableSets replaces the variable sets of a process, leaving its
+// other fields untouched.
+func (c *SpadeClient) UpdateProcessVariableSets(ctx context.Context, id int64, variableSets []int64) (*SpadeProcessReadResponse, error) {
+	if variableSets == nil {
+		variableSets = []int64{}
 	}
-	defer httpResp.Body.Close()
-
 	resp := SpadeProcessReadResponse{}
-	err = json.NewDecoder(httpResp.Body).Decode(&resp)
+	err := c.do(ctx, "update", "process", http.MethodPatch, "/api/v1/processes/"+fmt.Sprint(id), SpadeProcessVariableSetsUpdateRequest{
+		VariableSets: variableSets,
+	}, &resp)
 	if err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
-func (c *SpadeClient) DeleteProcess(id int64) error {
-	url, err := url.Parse(c.ApiUrl + "/api/v1/processes/" + fmt.Sprint(id))
-	if err != nil {
-		return err
-	}
-	httpReq := &http.Request{
-		Method: "DELETE",
-		URL:    url,
-		Header: map[string][]string{
-			"Authorization": {"Bearer " + c.Token},
-			"Content-Type":  {"application/json"},
-		},
-	}
-	httpResp, err := c.HttpClient.Do(httpReq)
-	if err != nil {
-		return err
-	}
-	defer httpResp.Body.Close()
-	return nil
+func (c *SpadeClient) DeleteProcess(ctx context.Context, id int64) error {
+	return c.do(ctx, "delete", "process", http.MethodDelete, "/api/v1/processes/"+fmt.Sprint(id), nil, nil)
+}
+
+func (c *SpadeClient) SearchProcess(ctx context.Context, code string) (*SpadeProcessReadResponse, error) {
+	return search(ctx, c, "process", "/api/v1/processes", "code", code, func(v SpadeProcessReadResponse) string {
+		return v.Code
+	})
+}
+
+func (c *SpadeClient) ListProcesses(ctx context.Context) ([]SpadeProcessReadResponse, error) {
+	return list[SpadeProcessReadResponse](ctx, c, "list", "processes", "/api/v1/processes")
 }
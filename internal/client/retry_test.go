@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"terraform-provider-spade/internal/spadetest"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	cases := []struct {
+		name   string
+		header string
+		min    time.Duration
+		max    time.Duration
+	}{
+		{name: "empty"},
+		{name: "seconds", header: "120", min: 2 * time.Minute, max: 2 * time.Minute},
+		{name: "zero seconds", header: "0"},
+		{name: "negative seconds", header: "-5"},
+		// HTTP dates have a resolution of one second
+		{
+			name:   "date",
+			header: time.Now().Add(time.Minute).UTC().Format(http.TimeFormat),
+			min:    58 * time.Second,
+			max:    time.Minute,
+		},
+		{name: "past date", header: time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)},
+		{name: "invalid", header: "soon"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := parseRetryAfter(tc.header)
+			if got < tc.min || got > tc.max {
+				t.Errorf("parseRetryAfter(%q) = %s, expected between %s and %s", tc.header, got, tc.min, tc.max)
+			}
+		})
+	}
+}
+
+func TestRetryWait(t *testing.T) {
+	c := &SpadeClient{RetryMinWait: time.Second, RetryMaxWait: 10 * time.Second}
+	throttled := &SpadeAPIError{StatusCode: http.StatusTooManyRequests}
+
+	cases := []struct {
+		name       string
+		attempt    int
+		retryAfter time.Duration
+		min        time.Duration
+		max        time.Duration
+	}{
+		{name: "first attempt", attempt: 0, min: 500 * time.Millisecond, max: time.Second},
+		{name: "backoff", attempt: 2, min: 2 * time.Second, max: 4 * time.Second},
+		{name: "backoff capped", attempt: 5, min: 5 * time.Second, max: 10 * time.Second},
+		{name: "backoff overflow", attempt: 70, min: 5 * time.Second, max: 10 * time.Second},
+		{name: "retry after", attempt: 0, retryAfter: 3 * time.Second, min: 3 * time.Second, max: 3 * time.Second},
+		{name: "retry after capped", attempt: 0, retryAfter: time.Hour, min: 10 * time.Second, max: 10 * time.Second},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			throttled.retryAfter = tc.retryAfter
+			got := c.retryWait(tc.attempt, throttled)
+			if got < tc.min || got > tc.max {
+				t.Errorf("retryWait(%d) = %s, expected between %s and %s", tc.attempt, got, tc.min, tc.max)
+			}
+		})
+	}
+}
+
+func TestRetryAfterHonoured(t *testing.T) {
+	server := spadetest.NewServer()
+	defer server.Close()
+	ctx := context.Background()
+	c := newTestClient(t, server)
+	c.RetryMaxWait = 10 * time.Second
+
+	server.ThrottleRequests(1, time.Second)
+	start := time.Now()
+	if _, err := c.CreateFileFormat(ctx, "csv"); err != nil {
+		t.Fatalf("create failed despite retries: %s", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("expected the retry to wait for Retry-After, retried after %s", elapsed)
+	}
+}
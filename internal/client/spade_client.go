@@ -2,16 +2,36 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
+	"errors"
 	"io"
 	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type SpadeClient struct {
-	ApiUrl     string
-	HttpClient *http.Client
-	Token      string
+	ApiUrl       string
+	HttpClient   *http.Client
+	Token        string
+	RefreshToken string
+	// Headers are added to every request sent to Spade.
+	Headers map[string]string
+
+	// MaxRetries is the number of times a request failing with a transient
+	// error is retried, waiting between RetryMinWait and RetryMaxWait.
+	MaxRetries   int
+	RetryMinWait time.Duration
+	RetryMaxWait time.Duration
+
+	// credentials used by Login, kept to re-login once the refresh token expires
+	email    string
+	password string
+	// guards Token and RefreshToken, which are rotated by concurrent requests
+	authMu sync.Mutex
 }
 
 type SpadeLoginRequest struct {
@@ -24,41 +44,136 @@ type SpadeLoginResponse struct {
 	RefreshToken string `json:"refresh"`
 }
 
-func (c *SpadeClient) Login(email, password string) error {
-	httpReqBody, err := json.Marshal(SpadeLoginRequest{
-		Email:    email,
-		Password: password,
-	})
+func (c *SpadeClient) Login(ctx context.Context, email, password string) error {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+
+	c.email = email
+	c.password = password
+	return c.login(ctx)
+}
+
+// login obtains a new token pair using the stored credentials. The caller must
+// hold authMu.
+func (c *SpadeClient) login(ctx context.Context) error {
+	resp := SpadeLoginResponse{}
+	err := c.send(ctx, "login", "", http.MethodPost, "/api/v1/token", "", SpadeLoginRequest{
+		Email:    c.email,
+		Password: c.password,
+	}, &resp)
 	if err != nil {
 		return err
 	}
+	c.Token = resp.AccessToken
+	c.RefreshToken = resp.RefreshToken
+	return nil
+}
 
-	httpReq, err := http.NewRequest(
-		"POST",
-		c.ApiUrl+"/api/v1/token",
-		bytes.NewBuffer(httpReqBody),
-	)
-	httpReq.Header.Set("Content-Type", "application/json")
+// do sends an authenticated request to the Spade API. If the access token is
+// rejected it is refreshed and the request is retried once. See send for
+// details on encoding and error handling.
+func (c *SpadeClient) do(ctx context.Context, operation, resource, method, path string, body, out interface{}) error {
+	token, err := c.accessToken(ctx)
 	if err != nil {
 		return err
 	}
-	httpResp, err := c.HttpClient.Do(httpReq)
-	if err != nil {
+	err = c.send(ctx, operation, resource, method, path, token, body, out)
+	if !hasStatus(err, http.StatusUnauthorized) {
 		return err
 	}
-	defer httpResp.Body.Close()
-	if !(httpResp.StatusCode >= 200 && httpResp.StatusCode < 300) {
-		bodyData, err := io.ReadAll(httpResp.Body)
+
+	token, refreshErr := c.reauthenticate(ctx, token)
+	if errors.Is(refreshErr, errCannotReauthenticate) {
+		return err
+	}
+	if refreshErr != nil {
+		return refreshErr
+	}
+	return c.send(ctx, operation, resource, method, path, token, body, out)
+}
+
+// send executes a request against the Spade API, authenticating with token
+// when it is not empty. If body is not nil it is encoded as JSON, and if out is
+// not nil the JSON response is decoded into it. Responses outside the 2xx range
+// are returned as a *SpadeAPIError describing the failed operation on the
+// resource. Transient failures are retried according to MaxRetries.
+func (c *SpadeClient) send(ctx context.Context, operation, resource, method, path, token string, body, out interface{}) error {
+	var data []byte
+	if body != nil {
+		var err error
+		data, err = json.Marshal(body)
 		if err != nil {
-			return fmt.Errorf("login failed with status code %d", httpResp.StatusCode)
+			return err
 		}
-		return fmt.Errorf("login failed with status code %d, response %s", httpResp.StatusCode, string(bodyData))
 	}
-	resp := SpadeLoginResponse{}
-	err = json.NewDecoder(httpResp.Body).Decode(&resp)
+
+	for attempt := 0; ; attempt++ {
+		err := c.sendOnce(ctx, operation, resource, method, path, token, data, out)
+		if err == nil || attempt >= c.MaxRetries || !isRetryable(ctx, method, err) {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(c.retryWait(attempt, err)):
+		}
+	}
+}
+
+func (c *SpadeClient) sendOnce(ctx context.Context, operation, resource, method, path, token string, data []byte, out interface{}) error {
+	var reqBody io.Reader
+	if data != nil {
+		reqBody = bytes.NewReader(data)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, method, c.ApiUrl+path, reqBody)
 	if err != nil {
 		return err
 	}
-	c.Token = resp.AccessToken
-	return nil
+	for key, value := range c.Headers {
+		httpReq.Header.Set(key, value)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	ctx = maskSensitiveValues(ctx, data)
+	start := time.Now()
+	httpResp, err := c.HttpClient.Do(httpReq)
+	if err != nil {
+		tflog.Debug(ctx, "Spade API request failed", map[string]interface{}{
+			"method":       method,
+			"path":         path,
+			"duration_ms":  time.Since(start).Milliseconds(),
+			"request_body": string(data),
+			"error":        err.Error(),
+		})
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	bodyData, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return err
+	}
+	ctx = maskSensitiveValues(ctx, bodyData)
+	tflog.Debug(ctx, "Spade API request", map[string]interface{}{
+		"method":        method,
+		"path":          path,
+		"status":        httpResp.StatusCode,
+		"duration_ms":   time.Since(start).Milliseconds(),
+		"request_body":  string(data),
+		"response_body": string(bodyData),
+	})
+
+	if !(httpResp.StatusCode >= 200 && httpResp.StatusCode < 300) {
+		apiErr := newSpadeAPIError(operation, resource, httpResp.StatusCode, bodyData)
+		apiErr.retryAfter = parseRetryAfter(httpResp.Header.Get("Retry-After"))
+		return apiErr
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(bodyData, out)
 }
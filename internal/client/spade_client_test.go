@@ -0,0 +1,192 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"terraform-provider-spade/internal/spadetest"
+)
+
+func newTestClient(t *testing.T, server *spadetest.Server) *SpadeClient {
+	t.Helper()
+
+	c := &SpadeClient{
+		ApiUrl:       server.URL,
+		HttpClient:   server.Client(),
+		MaxRetries:   3,
+		RetryMinWait: time.Millisecond,
+		RetryMaxWait: 10 * time.Millisecond,
+	}
+	if err := c.Login(context.Background(), server.Email, server.Password); err != nil {
+		t.Fatalf("login failed: %s", err)
+	}
+	return c
+}
+
+func TestLoginInvalidCredentials(t *testing.T) {
+	server := spadetest.NewServer()
+	defer server.Close()
+
+	c := &SpadeClient{ApiUrl: server.URL, HttpClient: server.Client()}
+	err := c.Login(context.Background(), server.Email, "wrong")
+	if !hasStatus(err, http.StatusUnauthorized) {
+		t.Fatalf("expected status code 401, got error: %v", err)
+	}
+}
+
+func TestRequestCancelled(t *testing.T) {
+	// the server never answers, so the request only ends with its context
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	c := &SpadeClient{ApiUrl: server.URL, HttpClient: server.Client(), Token: "token"}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := c.ReadVariable(ctx, 1)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the deadline of the context to be exceeded, got error: %v", err)
+	}
+}
+
+func TestRetryWaitCancelled(t *testing.T) {
+	server := spadetest.NewServer()
+	defer server.Close()
+	c := newTestClient(t, server)
+	c.RetryMinWait = time.Minute
+	c.RetryMaxWait = time.Minute
+
+	server.FailRequests(http.StatusServiceUnavailable, c.MaxRetries+1)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := c.ReadVariable(ctx, 1)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the deadline of the context to be exceeded, got error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("expected the wait between retries to end with the context, took %s", elapsed)
+	}
+}
+
+func TestVariableLifecycle(t *testing.T) {
+	server := spadetest.NewServer()
+	defer server.Close()
+	ctx := context.Background()
+	c := newTestClient(t, server)
+
+	created, err := c.CreateVariable(ctx, "db_host", "database host", "localhost", true)
+	if err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+	updated, err := c.UpdateVariable(ctx, created.Id, "db_host", "database host", "db.example.com", true)
+	if err != nil {
+		t.Fatalf("update failed: %s", err)
+	}
+	// secret values are stored but never returned
+	if updated.Value != "" {
+		t.Errorf("expected the value of a secret variable to be hidden, got %q", updated.Value)
+	}
+	if value := server.Object("variables", created.Id)["value"]; value != "db.example.com" {
+		t.Errorf("expected updated value db.example.com, got %v", value)
+	}
+	read, err := c.ReadVariable(ctx, created.Id)
+	if err != nil {
+		t.Fatalf("read failed: %s", err)
+	}
+	if *read != *updated {
+		t.Errorf("expected %+v, got %+v", *updated, *read)
+	}
+
+	if err := c.DeleteVariable(ctx, created.Id); err != nil {
+		t.Fatalf("delete failed: %s", err)
+	}
+	read, err = c.ReadVariable(ctx, created.Id)
+	if err != nil || read != nil {
+		t.Fatalf("expected deleted variable to be missing, got %+v, error: %v", read, err)
+	}
+}
+
+func TestValidationError(t *testing.T) {
+	server := spadetest.NewServer()
+	defer server.Close()
+	ctx := context.Background()
+	c := newTestClient(t, server)
+
+	if _, err := c.CreateGroup(ctx, "analysts"); err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+	_, err := c.CreateGroup(ctx, "analysts")
+
+	var apiErr *SpadeAPIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("expected status code 400, got error: %v", err)
+	}
+	if len(apiErr.FieldErrors["name"]) != 1 {
+		t.Errorf("expected one error for name, got %v", apiErr.FieldErrors)
+	}
+}
+
+func TestSearchVariableFollowsPages(t *testing.T) {
+	server := spadetest.NewServer()
+	defer server.Close()
+	server.PageSize = 2
+	ctx := context.Background()
+	c := newTestClient(t, server)
+
+	for i := 1; i <= 4; i++ {
+		if _, err := c.CreateVariable(ctx, fmt.Sprintf("region_%d", i), "", "", false); err != nil {
+			t.Fatalf("create failed: %s", err)
+		}
+	}
+	created, err := c.CreateVariable(ctx, "region", "", "eu-west-1", false)
+	if err != nil {
+		t.Fatalf("create failed: %s", err)
+	}
+
+	found, err := c.SearchVariable(ctx, "region")
+	if err != nil {
+		t.Fatalf("search failed: %s", err)
+	}
+	if found.Id != created.Id {
+		t.Errorf("expected variable %d, got %d", created.Id, found.Id)
+	}
+}
+
+func TestReauthenticateAfterTokenExpiry(t *testing.T) {
+	server := spadetest.NewServer()
+	defer server.Close()
+	ctx := context.Background()
+	c := newTestClient(t, server)
+
+	server.ExpireTokens()
+	if _, err := c.CreateExecutor(ctx, "local", "", "executors.Local", ""); err != nil {
+		t.Fatalf("create failed after token expiry: %s", err)
+	}
+}
+
+func TestRetryTransientErrors(t *testing.T) {
+	server := spadetest.NewServer()
+	defer server.Close()
+	ctx := context.Background()
+	c := newTestClient(t, server)
+
+	server.FailRequests(http.StatusServiceUnavailable, 2)
+	if _, err := c.CreateFileFormat(ctx, "csv"); err != nil {
+		t.Fatalf("create failed despite retries: %s", err)
+	}
+
+	server.FailRequests(http.StatusBadGateway, 1)
+	_, err := c.CreateFileFormat(ctx, "json")
+	if !hasStatus(err, http.StatusBadGateway) {
+		t.Fatalf("expected POST not to be retried on status code 502, got error: %v", err)
+	}
+}
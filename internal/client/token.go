@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// tokenExpiryLeeway is how long before its expiry an access token is refreshed
+// proactively, so that requests are not sent with a token about to lapse.
+const tokenExpiryLeeway = 30 * time.Second
+
+var errCannotReauthenticate = errors.New("no refresh token or credentials available")
+
+type SpadeTokenRefreshRequest struct {
+	Refresh string `json:"refresh"`
+}
+
+type SpadeTokenRefreshResponse struct {
+	AccessToken  string `json:"access"`
+	RefreshToken string `json:"refresh"`
+}
+
+// accessToken returns the token to authenticate the next request with,
+// refreshing it first if it is about to expire.
+func (c *SpadeClient) accessToken(ctx context.Context) (string, error) {
+	c.authMu.Lock()
+	token := c.Token
+	c.authMu.Unlock()
+
+	expiry, ok := tokenExpiry(token)
+	if !ok || time.Until(expiry) > tokenExpiryLeeway {
+		return token, nil
+	}
+	newToken, err := c.reauthenticate(ctx, token)
+	if errors.Is(err, errCannotReauthenticate) {
+		return token, nil
+	}
+	return newToken, err
+}
+
+// reauthenticate replaces staleToken with a fresh access token, using the
+// refresh token if possible and falling back to a full login. If another
+// request already replaced staleToken, the current token is returned as is.
+// The token endpoints are called through send rather than do, so that a 401
+// while holding authMu is returned instead of re-entering reauthenticate.
+func (c *SpadeClient) reauthenticate(ctx context.Context, staleToken string) (string, error) {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+
+	if c.Token != staleToken {
+		return c.Token, nil
+	}
+
+	if c.RefreshToken != "" {
+		resp := SpadeTokenRefreshResponse{}
+		err := c.send(ctx, "refresh", "token", http.MethodPost, "/api/v1/token/refresh", "", SpadeTokenRefreshRequest{
+			Refresh: c.RefreshToken,
+		}, &resp)
+		if err == nil {
+			c.Token = resp.AccessToken
+			// only set when the server rotates refresh tokens
+			if resp.RefreshToken != "" {
+				c.RefreshToken = resp.RefreshToken
+			}
+			return c.Token, nil
+		}
+		if !hasStatus(err, http.StatusUnauthorized) || c.email == "" {
+			return "", err
+		}
+	}
+
+	if c.email == "" {
+		return "", errCannotReauthenticate
+	}
+	if err := c.login(ctx); err != nil {
+		return "", err
+	}
+	return c.Token, nil
+}
+
+// tokenExpiry extracts the exp claim from a JWT without verifying it. The
+// second return value is false if token is not a JWT with an expiry.
+func tokenExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, false
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(claims.Exp, 0), true
+}
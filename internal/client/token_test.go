@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"terraform-provider-spade/internal/spadetest"
+)
+
+// newTokenClient returns a client holding the tokens of a login to server but
+// not the credentials, so that it can only reauthenticate with the refresh
+// token.
+func newTokenClient(t *testing.T, server *spadetest.Server) *SpadeClient {
+	t.Helper()
+
+	login := newTestClient(t, server)
+	return &SpadeClient{
+		ApiUrl:       server.URL,
+		HttpClient:   server.Client(),
+		Token:        login.Token,
+		RefreshToken: login.RefreshToken,
+	}
+}
+
+func TestTokenExpiry(t *testing.T) {
+	server := spadetest.NewServer()
+	defer server.Close()
+	c := newTestClient(t, server)
+
+	expiry, ok := tokenExpiry(c.Token)
+	if !ok {
+		t.Fatalf("expected an expiry in token %q", c.Token)
+	}
+	if d := time.Until(expiry); d <= 0 || d > spadetest.DefaultAccessTokenLifetime {
+		t.Errorf("expected the token to expire within %s, expires in %s", spadetest.DefaultAccessTokenLifetime, d)
+	}
+
+	if _, ok := tokenExpiry("opaque-token"); ok {
+		t.Error("expected no expiry for a token which is not a JWT")
+	}
+}
+
+func TestRefreshTokenBeforeExpiry(t *testing.T) {
+	server := spadetest.NewServer()
+	defer server.Close()
+	ctx := context.Background()
+
+	// the token expires within the leeway, so it is refreshed before use
+	server.AccessTokenLifetime = tokenExpiryLeeway / 3
+	c := newTokenClient(t, server)
+	token := c.Token
+
+	if _, err := c.CreateExecutor(ctx, "local", "", "executors.Local", ""); err != nil {
+		t.Fatalf("create failed with a token about to expire: %s", err)
+	}
+	if c.Token == token {
+		t.Error("expected the access token to be refreshed before the request")
+	}
+}
+
+func TestLoginAfterRefreshTokenExpiry(t *testing.T) {
+	server := spadetest.NewServer()
+	defer server.Close()
+	ctx := context.Background()
+	c := newTestClient(t, server)
+	refreshToken := c.RefreshToken
+
+	server.ExpireTokens()
+	server.ExpireRefreshTokens()
+	if _, err := c.CreateExecutor(ctx, "local", "", "executors.Local", ""); err != nil {
+		t.Fatalf("create failed after refresh token expiry: %s", err)
+	}
+	if c.RefreshToken == refreshToken {
+		t.Error("expected a new refresh token from logging in again")
+	}
+}
+
+func TestRefreshUnauthorized(t *testing.T) {
+	server := spadetest.NewServer()
+	defer server.Close()
+	ctx := context.Background()
+	c := newTokenClient(t, server)
+
+	server.ExpireTokens()
+	server.ExpireRefreshTokens()
+
+	// the second request would block on authMu if the first left it held
+	done := make(chan error)
+	go func() {
+		for i := 0; i < 2; i++ {
+			_, err := c.ReadVariable(ctx, 1)
+			done <- err
+		}
+	}()
+	for i := 0; i < 2; i++ {
+		select {
+		case err := <-done:
+			if !hasStatus(err, http.StatusUnauthorized) {
+				t.Fatalf("expected status code 401, got error: %v", err)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatal("request did not return after the refresh was rejected")
+		}
+	}
+}
@@ -1,12 +1,9 @@
 package client
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
-	"io"
 	"net/http"
-	"net/url"
 )
 
 type SpadeUserCreateRequest struct {
@@ -17,6 +14,10 @@ type SpadeUserCreateRequest struct {
 	Groups    []int64 `json:"groups"`
 }
 
+type SpadeUserGroupsUpdateRequest struct {
+	Groups []int64 `json:"groups"`
+}
+
 type SpadeUserReadResponse struct {
 	Id        int64   `json:"id"`
 	FirstName string  `json:"first_name"`
@@ -26,148 +27,74 @@ type SpadeUserReadResponse struct {
 	Groups    []int64 `json:"groups"`
 }
 
-func (c *SpadeClient) CreateUser(firstName, lastName, email string, isActive bool, groups []int64) (*SpadeUserReadResponse, error) {
-	httpReqBody, err := json.Marshal(SpadeUserCreateRequest{
+func (c *SpadeClient) CreateUser(ctx context.Context, firstName, lastName, email string, isActive bool, groups []int64) (*SpadeUserReadResponse, error) {
+	resp := SpadeUserReadResponse{}
+	err := c.do(ctx, "create", "user", http.MethodPost, "/api/v1/users", SpadeUserCreateRequest{
 		FirstName: firstName,
 		LastName:  lastName,
 		Email:     email,
 		IsActive:  isActive,
 		Groups:    groups,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	httpReq, err := http.NewRequest(
-		"POST",
-		c.ApiUrl+"/api/v1/users",
-		bytes.NewBuffer(httpReqBody),
-	)
-	httpReq.Header.Set("Authorization", "Bearer "+c.Token)
-	httpReq.Header.Set("Content-Type", "application/json")
-	if err != nil {
-		return nil, err
-	}
-	httpResp, err := c.HttpClient.Do(httpReq)
-	if err != nil {
-		return nil, err
-	}
-	defer httpResp.Body.Close()
-	if !(httpResp.StatusCode >= 200 && httpResp.StatusCode < 300) {
-		bodyData, err := io.ReadAll(httpResp.Body)
-		if err != nil {
-			return nil, fmt.Errorf("create user failed with status code %d", httpResp.StatusCode)
-		}
-		return nil, fmt.Errorf("create user failed with status code %d, response %s", httpResp.StatusCode, string(bodyData))
-	}
-	resp := SpadeUserReadResponse{}
-	err = json.NewDecoder(httpResp.Body).Decode(&resp)
+	}, &resp)
 	if err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
-func (c *SpadeClient) ReadUser(id int64) (*SpadeUserReadResponse, error) {
-	httpReq, err := http.NewRequest(
-		"GET",
-		c.ApiUrl+"/api/v1/users/"+fmt.Sprint(id),
-		nil,
-	)
-	httpReq.Header.Set("Authorization", "Bearer "+c.Token)
-	httpReq.Header.Set("Content-Type", "application/json")
-	if err != nil {
-		return nil, err
-	}
-	httpResp, err := c.HttpClient.Do(httpReq)
-	if err != nil {
-		return nil, err
-	}
-	defer httpResp.Body.Close()
-	if httpResp.StatusCode == 404 {
+func (c *SpadeClient) ReadUser(ctx context.Context, id int64) (*SpadeUserReadResponse, error) {
+	resp := SpadeUserReadResponse{}
+	err := c.do(ctx, "read", "user", http.MethodGet, "/api/v1/users/"+fmt.Sprint(id), nil, &resp)
+	if IsNotFound(err) {
 		return nil, nil
 	}
-	if !(httpResp.StatusCode >= 200 && httpResp.StatusCode < 300) {
-		bodyData, err := io.ReadAll(httpResp.Body)
-		if err != nil {
-			return nil, fmt.Errorf("read user failed with status code %d", httpResp.StatusCode)
-		}
-		return nil, fmt.Errorf("read user failed with status code %d, response %s", httpResp.StatusCode, string(bodyData))
-	}
-	resp := SpadeUserReadResponse{}
-	err = json.NewDecoder(httpResp.Body).Decode(&resp)
 	if err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
-func (c *SpadeClient) UpdateUser(id int64, firstName, lastName, email string, isActive bool, groups []int64) (*SpadeUserReadResponse, error) {
-	httpReqBody, err := json.Marshal(SpadeUserCreateRequest{
+func (c *SpadeClient) UpdateUser(ctx context.Context, id int64, firstName, lastName, email string, isActive bool, groups []int64) (*SpadeUserReadResponse, error) {
+	resp := SpadeUserReadResponse{}
+	err := c.do(ctx, "update", "user", http.MethodPatch, "/api/v1/users/"+fmt.Sprint(id), SpadeUserCreateRequest{
 		FirstName: firstName,
 		LastName:  lastName,
 		Email:     email,
 		IsActive:  isActive,
 		Groups:    groups,
-	})
+	}, &resp)
 	if err != nil {
 		return nil, err
 	}
+	return &resp, nil
+}
 
-	httpReq, err := http.NewRequest(
-		"PATCH",
-		c.ApiUrl+"/api/v1/users/"+fmt.Sprint(id),
-		bytes.NewBuffer(httpReqBody),
-	)
-	httpReq.Header.Set("Authorization", "Bearer "+c.Token)
-	httpReq.Header.Set("Content-Type", "application/json")
-	if err != nil {
-		return nil, err
-	}
-	httpResp, err := c.HttpClient.Do(httpReq)
-	if err != nil {
-		return nil, err
-	}
-	defer httpResp.Body.Close()
-	if !(httpResp.StatusCode >= 200 && httpResp.StatusCode < 300) {
-		bodyData, err := io.ReadAll(httpResp.Body)
-		if err != nil {
-			return nil, fmt.Errorf("update user failed with status code %d", httpResp.StatusCode)
-		}
-		return nil, fmt.Errorf("update user failed with status code %d, response %s", httpResp.StatusCode, string(bodyData))
+// UpdateUserGroups replaces the groups of a user, leaving its other fields
+// untouched.
+func (c *SpadeClient) UpdateUserGroups(ctx context.Context, id int64, groups []int64) (*SpadeUserReadResponse, error) {
+	if groups == nil {
+		groups = []int64{}
 	}
 	resp := SpadeUserReadResponse{}
-	err = json.NewDecoder(httpResp.Body).Decode(&resp)
+	err := c.do(ctx, "update", "user", http.MethodPatch, "/api/v1/users/"+fmt.Sprint(id), SpadeUserGroupsUpdateRequest{
+		Groups: groups,
+	}, &resp)
 	if err != nil {
 		return nil, err
 	}
 	return &resp, nil
 }
 
-func (c *SpadeClient) DeleteUser(id int64) error {
-	url, err := url.Parse(c.ApiUrl + "/api/v1/users/" + fmt.Sprint(id))
-	if err != nil {
-		return err
-	}
-	httpReq := &http.Request{
-		Method: "DELETE",
-		URL:    url,
-		Header: map[string][]string{
-			"Authorization": {"Bearer " + c.Token},
-			"Content-Type":  {"application/json"},
-		},
-	}
-	httpResp, err := c.HttpClient.Do(httpReq)
-	if err != nil {
-		return err
-	}
-	defer httpResp.Body.Close()
-	if !(httpResp.StatusCode >= 200 && httpResp.StatusCode < 300) {
-		bodyData, err := io.ReadAll(httpResp.Body)
-		if err != nil {
-			return fmt.Errorf("delete user failed with status code %d", httpResp.StatusCode)
-		}
-		return fmt.Errorf("delete user failed with status code %d, response %s", httpResp.StatusCode, string(bodyData))
-	}
-	return nil
+func (c *SpadeClient) DeleteUser(ctx context.Context, id int64) error {
+	return c.do(ctx, "delete", "user", http.MethodDelete, "/api/v1/users/"+fmt.Sprint(id), nil, nil)
+}
+
+func (c *SpadeClient) SearchUser(ctx context.Context, email string) (*SpadeUserReadResponse, error) {
+	return search(ctx, c, "user", "/api/v1/users", "email", email, func(v SpadeUserReadResponse) string {
+		return v.Email
+	})
+}
+
+func (c *SpadeClient) ListUsers(ctx context.Context) ([]SpadeUserReadResponse, error) {
+	return list[SpadeUserReadResponse](ctx, c, "list", "users", "/api/v1/users")
 }
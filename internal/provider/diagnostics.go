@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	spade "terraform-provider-spade/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// apiFieldPaths maps the fields of a Spade API request to the attributes of a
+// resource, so that validation errors are reported against the value at fault.
+type apiFieldPaths map[string]path.Path
+
+// sameFieldPaths returns the apiFieldPaths of fields which are named the same
+// as the top-level attributes they are set from.
+func sameFieldPaths(fields ...string) apiFieldPaths {
+	paths := make(apiFieldPaths, len(fields))
+	for _, field := range fields {
+		paths[field] = path.Root(field)
+	}
+	return paths
+}
+
+// addClientError adds a diagnostic for an error returned by the Spade client.
+// Validation errors for the fields in paths are reported against the matching
+// attribute, so that Terraform points at the value the server rejected. Errors
+// for other fields are reported without an attribute.
+func addClientError(diags *diag.Diagnostics, message string, err error, paths apiFieldPaths) {
+	var apiErr *spade.SpadeAPIError
+	if !errors.As(err, &apiErr) || len(apiErr.FieldErrors) == 0 {
+		diags.AddError("Client Error", fmt.Sprintf("%s, got error: %s", message, err))
+		return
+	}
+
+	fields := make([]string, 0, len(apiErr.FieldErrors))
+	for field := range apiErr.FieldErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		reason := strings.Join(apiErr.FieldErrors[field], " ")
+		if attribute, ok := paths[field]; ok {
+			diags.AddAttributeError(
+				attribute,
+				"Invalid Attribute Value",
+				fmt.Sprintf("%s, Spade rejected %s with status code %d: %s", message, attribute, apiErr.StatusCode, reason),
+			)
+		} else {
+			diags.AddError(
+				"Invalid Value",
+				fmt.Sprintf("%s, Spade rejected field %s with status code %d: %s", message, field, apiErr.StatusCode, reason),
+			)
+		}
+	}
+	if len(apiErr.Messages) > 0 {
+		diags.AddError("Client Error", fmt.Sprintf("%s, got error: %s", message, strings.Join(apiErr.Messages, " ")))
+	}
+}
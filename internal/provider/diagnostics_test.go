@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"net/http"
+	spade "terraform-provider-spade/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestAddClientError(t *testing.T) {
+	paths := apiFieldPaths{
+		"is_active": path.Root("active"),
+		"groups":    path.Root("group"),
+	}
+	cases := []struct {
+		name string
+		err  error
+		// paths are the attributes of the diagnostics in order, empty for
+		// those not tied to an attribute
+		paths  []path.Path
+		errors int
+	}{
+		{
+			name:   "not an API error",
+			err:    errors.New("connection refused"),
+			errors: 1,
+		},
+		{
+			name:   "no field errors",
+			err:    &spade.SpadeAPIError{StatusCode: http.StatusBadRequest, Messages: []string{"invalid"}},
+			errors: 1,
+		},
+		{
+			name: "mapped fields",
+			err: &spade.SpadeAPIError{
+				StatusCode:  http.StatusBadRequest,
+				FieldErrors: map[string][]string{"groups": {"invalid pk"}, "is_active": {"must be a boolean"}},
+			},
+			paths:  []path.Path{path.Root("group"), path.Root("active")},
+			errors: 2,
+		},
+		{
+			name: "unmapped field",
+			err: &spade.SpadeAPIError{
+				StatusCode:  http.StatusBadRequest,
+				FieldErrors: map[string][]string{"email": {"already exists"}},
+				Messages:    []string{"invalid"},
+			},
+			paths:  []path.Path{path.Empty(), path.Empty()},
+			errors: 2,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			addClientError(&diags, "Unable to update user", tc.err, paths)
+
+			if diags.ErrorsCount() != tc.errors {
+				t.Fatalf("expected %d errors, got %v", tc.errors, diags)
+			}
+			for i, expected := range tc.paths {
+				got := path.Empty()
+				if withPath, ok := diags[i].(diag.DiagnosticWithPath); ok {
+					got = withPath.Path()
+				}
+				if !got.Equal(expected) {
+					t.Errorf("expected %q to be reported against %q, got %q", diags[i].Detail(), expected, got)
+				}
+			}
+		})
+	}
+}
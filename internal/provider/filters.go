@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// nameFilter selects objects of the plural data sources by the prefix and
+// regular expression filters set on their name or code.
+type nameFilter struct {
+	prefix string
+	regex  *regexp.Regexp
+}
+
+// newNameFilter builds a nameFilter from the prefix and regex attributes,
+// either of which may be null. An invalid regex is reported on regexPath.
+func newNameFilter(prefix, regex types.String, regexPath path.Path, diags *diag.Diagnostics) nameFilter {
+	filter := nameFilter{prefix: prefix.ValueString()}
+	if regex.IsNull() {
+		return filter
+	}
+	re, err := regexp.Compile(regex.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			regexPath,
+			"Invalid Regular Expression",
+			fmt.Sprintf("Unable to compile %s, got error: %s", regexPath, err),
+		)
+		return filter
+	}
+	filter.regex = re
+	return filter
+}
+
+func (f nameFilter) matches(name string) bool {
+	if !strings.HasPrefix(name, f.prefix) {
+		return false
+	}
+	return f.regex == nil || f.regex.MatchString(name)
+}
+
+// tagFilter returns the tags an object must all have to be selected, or nil
+// when the tags attribute is not set.
+func tagFilter(ctx context.Context, tags types.Set, diags *diag.Diagnostics) []string {
+	if tags.IsNull() {
+		return nil
+	}
+	var required []string
+	diags.Append(tags.ElementsAs(ctx, &required, false)...)
+	return required
+}
+
+func hasAllTags(tags, required []string) bool {
+	for _, tag := range required {
+		found := false
+		for _, t := range tags {
+			if t == tag {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
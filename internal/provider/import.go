@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// importId resolves the identifier given to terraform import, which is either
+// a numeric ID or a natural key of the form <key>:<value>, e.g. name:etl.
+// Natural keys are resolved to an ID with lookup.
+func importId(ctx context.Context, id, key string, lookup func(context.Context, string) (int64, error), diags *diag.Diagnostics) (int64, bool) {
+	value, ok := strings.CutPrefix(id, key+":")
+	if !ok {
+		numericId, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			diags.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected a numeric resource ID or %s:<%s>, got: %s", key, key, id),
+			)
+			return 0, false
+		}
+		return numericId, true
+	}
+
+	resolvedId, err := lookup(ctx, value)
+	if err != nil {
+		addClientError(diags, fmt.Sprintf("Unable to find resource with %s %s", key, value), err, nil)
+		return 0, false
+	}
+	return resolvedId, true
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"slices"
+	"time"
+)
+
+// memberListAttempts is the number of times a change to a member list is
+// written before giving up, when other writers keep changing the list.
+const memberListAttempts = 5
+
+// memberListRetryWait is the longest wait before writing a change to a member
+// list again. The wait is jittered so that concurrent writers spread out.
+var memberListRetryWait = time.Second
+
+// memberList is a list of identifiers held by a Spade object, such as the
+// variable sets of a process, which resources add identifiers to and remove
+// identifiers from without owning the whole list.
+type memberList struct {
+	// name and id identify the object holding the list in errors.
+	name string
+	id   int64
+	// mutexKey is the spadeMutexKV key guarding the object.
+	mutexKey string
+	// read returns the identifiers in the list, with found unset if the
+	// object does not exist.
+	read func(ctx context.Context) (ids []int64, found bool, err error)
+	// update replaces the identifiers in the list, leaving the other fields
+	// of the object untouched.
+	update func(ctx context.Context, ids []int64) error
+}
+
+// set adds member to, or removes it from, the list. Spade only allows
+// replacing the whole list, so it is read and written back. Writes from this
+// provider are serialised by a lock. Other writers, such as another Terraform
+// run, may still replace the list between the read and the write, so the list
+// is read back after writing. If it differs from what was written, the change
+// is made again, up to memberListAttempts times.
+func (l memberList) set(ctx context.Context, member int64, present bool) error {
+	spadeMutexKV.Lock(l.mutexKey)
+	defer spadeMutexKV.Unlock(l.mutexKey)
+
+	for attempt := 1; ; attempt++ {
+		ids, found, err := l.read(ctx)
+		if err != nil {
+			return err
+		}
+		if !found {
+			if !present {
+				// Nothing to remove
+				return nil
+			}
+			return fmt.Errorf("cannot find %s with id: %d", l.name, l.id)
+		}
+
+		index := slices.Index(ids, member)
+		switch {
+		case present && index < 0:
+			ids = append(ids, member)
+		case !present && index >= 0:
+			ids = slices.Delete(ids, index, index+1)
+		default:
+			// Already done, possibly by the previous attempt
+			return nil
+		}
+		if err := l.update(ctx, ids); err != nil {
+			return err
+		}
+
+		written, found, err := l.read(ctx)
+		if err != nil {
+			return err
+		}
+		if !found && !present {
+			return nil
+		}
+		if found && sameMembers(written, ids) {
+			return nil
+		}
+		if attempt == memberListAttempts {
+			return fmt.Errorf("%s with id: %d was changed by another writer on each of %d attempts", l.name, l.id, attempt)
+		}
+
+		wait := memberListRetryWait/2 + time.Duration(rand.Int63n(int64(memberListRetryWait/2)+1))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// sameMembers reports whether a and b hold the same identifiers, in any order.
+func sameMembers(a, b []int64) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
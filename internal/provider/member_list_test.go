@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// testMemberList is a member list held in memory. concurrentWrite, when set,
+// is called after each update with the list as written and the number of
+// updates so far, and returns the list as another writer leaves it.
+type testMemberList struct {
+	ids             []int64
+	found           bool
+	updates         int
+	concurrentWrite func(ids []int64, updates int) []int64
+}
+
+func (l *testMemberList) list() memberList {
+	return memberList{
+		name:     "variable set",
+		id:       1,
+		mutexKey: "test",
+		read: func(ctx context.Context) ([]int64, bool, error) {
+			return slices.Clone(l.ids), l.found, nil
+		},
+		update: func(ctx context.Context, ids []int64) error {
+			l.updates++
+			l.ids = slices.Clone(ids)
+			if l.concurrentWrite != nil {
+				l.ids = l.concurrentWrite(l.ids, l.updates)
+			}
+			return nil
+		},
+	}
+}
+
+func TestMemberListSet(t *testing.T) {
+	wait := memberListRetryWait
+	memberListRetryWait = 0
+	t.Cleanup(func() { memberListRetryWait = wait })
+
+	cases := []struct {
+		name            string
+		ids             []int64
+		missing         bool
+		member          int64
+		present         bool
+		concurrentWrite func(ids []int64, updates int) []int64
+		expected        []int64
+		updates         int
+		err             string
+	}{
+		{
+			name:     "add",
+			ids:      []int64{2},
+			member:   3,
+			present:  true,
+			expected: []int64{2, 3},
+			updates:  1,
+		},
+		{
+			name:     "remove",
+			ids:      []int64{2, 3},
+			member:   3,
+			expected: []int64{2},
+			updates:  1,
+		},
+		{
+			name:     "already added",
+			ids:      []int64{2, 3},
+			member:   3,
+			present:  true,
+			expected: []int64{2, 3},
+		},
+		{
+			name:     "already removed",
+			ids:      []int64{2},
+			member:   3,
+			expected: []int64{2},
+		},
+		{
+			name:    "add to missing object",
+			missing: true,
+			member:  3,
+			present: true,
+			err:     "cannot find variable set with id: 1",
+		},
+		{
+			name:    "remove from missing object",
+			missing: true,
+			member:  3,
+		},
+		{
+			// another run, having read the list before this update, writes
+			// it back with its own variable only
+			name:    "add lost to a concurrent write",
+			ids:     []int64{2},
+			member:  3,
+			present: true,
+			concurrentWrite: func(ids []int64, updates int) []int64 {
+				if updates == 1 {
+					return []int64{2, 4}
+				}
+				return ids
+			},
+			expected: []int64{2, 4, 3},
+			updates:  2,
+		},
+		{
+			name:   "removal lost to a concurrent write",
+			ids:    []int64{2, 3},
+			member: 3,
+			concurrentWrite: func(ids []int64, updates int) []int64 {
+				if updates == 1 {
+					return []int64{2, 3, 4}
+				}
+				return ids
+			},
+			expected: []int64{2, 4},
+			updates:  2,
+		},
+		{
+			// the change is kept, so it is not written again
+			name:    "concurrent write keeping the change",
+			ids:     []int64{2},
+			member:  3,
+			present: true,
+			concurrentWrite: func(ids []int64, updates int) []int64 {
+				return append(ids, 4)
+			},
+			expected: []int64{2, 3, 4},
+			updates:  1,
+		},
+		{
+			name:    "change always lost",
+			ids:     []int64{2},
+			member:  3,
+			present: true,
+			concurrentWrite: func(ids []int64, updates int) []int64 {
+				return []int64{2}
+			},
+			updates: memberListAttempts,
+			err:     "variable set with id: 1 was changed by another writer on each of 5 attempts",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			l := &testMemberList{ids: tc.ids, found: !tc.missing, concurrentWrite: tc.concurrentWrite}
+			err := l.list().set(context.Background(), tc.member, tc.present)
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("expected error %q, got: %v", tc.err, err)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if !slices.Equal(l.ids, tc.expected) {
+					t.Errorf("expected %v, got %v", tc.expected, l.ids)
+				}
+			}
+			if l.updates != tc.updates {
+				t.Errorf("expected %d updates, got %d", tc.updates, l.updates)
+			}
+		})
+	}
+}
+
+func TestMemberListSetCancelled(t *testing.T) {
+	l := &testMemberList{
+		ids:   []int64{2},
+		found: true,
+		concurrentWrite: func(ids []int64, updates int) []int64 {
+			return []int64{2}
+		},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := l.list().set(ctx, 3, true); err != context.Canceled {
+		t.Errorf("expected the wait between attempts to end with the context, got: %v", err)
+	}
+	if l.updates != 1 {
+		t.Errorf("expected 1 update, got %d", l.updates)
+	}
+}
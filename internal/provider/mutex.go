@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"sync"
+)
+
+// spadeMutexKV serializes read-modify-write updates of Spade objects shared
+// by several resources, such as the groups of a user or the variables of a
+// variable set.
+var spadeMutexKV = newMutexKV()
+
+// mutexKV is a set of mutexes identified by a key, created on first use.
+type mutexKV struct {
+	lock  sync.Mutex
+	store map[string]*sync.Mutex
+}
+
+func newMutexKV() *mutexKV {
+	return &mutexKV{
+		store: make(map[string]*sync.Mutex),
+	}
+}
+
+// Lock locks the mutex for the given key, creating it if necessary.
+func (m *mutexKV) Lock(key string) {
+	m.get(key).Lock()
+}
+
+// Unlock unlocks the mutex for the given key.
+func (m *mutexKV) Unlock(key string) {
+	m.get(key).Unlock()
+}
+
+func (m *mutexKV) get(key string) *sync.Mutex {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	mutex, ok := m.store[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		m.store[key] = mutex
+	}
+	return mutex
+}
+
+// userMutexKey returns the spadeMutexKV key guarding the user with
+// identifier id.
+func userMutexKey(id int64) string {
+	return fmt.Sprintf("spade_user/%d", id)
+}
+
+// variableSetMutexKey returns the spadeMutexKV key guarding the variable set
+// with identifier id.
+func variableSetMutexKey(id int64) string {
+	return fmt.Sprintf("spade_variable_set/%d", id)
+}
+
+// processMutexKey returns the spadeMutexKV key guarding the process with
+// identifier id.
+func processMutexKey(id int64) string {
+	return fmt.Sprintf("spade_process/%d", id)
+}
+
+// fileMutexKey returns the spadeMutexKV key guarding the file with identifier
+// id.
+func fileMutexKey(id int64) string {
+	return fmt.Sprintf("spade_file/%d", id)
+}
@@ -6,10 +6,16 @@ package provider
 import (
 	"context"
 	"fmt"
-	"net/http"
+	"os"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/providervalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -22,6 +28,7 @@ import (
 // Ensure SpadeProvider satisfies various provider interfaces.
 var _ provider.Provider = &SpadeProvider{}
 var _ provider.ProviderWithFunctions = &SpadeProvider{}
+var _ provider.ProviderWithConfigValidators = &SpadeProvider{}
 
 // SpadeProvider defines the provider implementation.
 type SpadeProvider struct {
@@ -31,11 +38,31 @@ type SpadeProvider struct {
 	version string
 }
 
+// Retry settings used when not set in the provider configuration.
+const (
+	defaultMaxRetries   = 4
+	defaultRetryMinWait = time.Second
+	defaultRetryMaxWait = 30 * time.Second
+)
+
 // SpadeProviderModel describes the provider data model.
 type SpadeProviderModel struct {
-	URL      types.String `tfsdk:"url"`
-	Email    types.String `tfsdk:"email"`
-	Password types.String `tfsdk:"password"`
+	URL                types.String `tfsdk:"url"`
+	Email              types.String `tfsdk:"email"`
+	Password           types.String `tfsdk:"password"`
+	Token              types.String `tfsdk:"token"`
+	TokenFile          types.String `tfsdk:"token_file"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	Headers            types.Map    `tfsdk:"headers"`
+	RequestTimeout     types.String `tfsdk:"request_timeout"`
+	MaxRetries         types.Int64  `tfsdk:"max_retries"`
+	RetryMinWait       types.String `tfsdk:"retry_min_wait"`
+	RetryMaxWait       types.String `tfsdk:"retry_max_wait"`
 }
 
 func (p *SpadeProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -48,18 +75,74 @@ func (p *SpadeProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 		MarkdownDescription: "Terraform provider for managing Spade connectors",
 		Attributes: map[string]schema.Attribute{
 			"url": schema.StringAttribute{
-				MarkdownDescription: "Spade URL",
-				Required:            true,
+				MarkdownDescription: "Spade URL. Can also be set with the `SPADE_URL` environment variable",
+				Optional:            true,
 			},
 			"email": schema.StringAttribute{
-				MarkdownDescription: "Login email address",
-				Required:            true,
+				MarkdownDescription: "Login email address. Conflicts with `token` and `token_file`. Can also be set with the `SPADE_EMAIL` environment variable",
+				Optional:            true,
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "Login password",
-				Required:            true,
+				MarkdownDescription: "Login password, required with `email`. Conflicts with `token` and `token_file`. Can also be set with the `SPADE_PASSWORD` environment variable",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "Pre-issued API bearer token, used instead of logging in with `email` and `password`. Can also be set with the `SPADE_TOKEN` environment variable, which takes precedence over `SPADE_EMAIL` but not over credentials set in the configuration",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"token_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing a pre-issued API bearer token, used instead of logging in with `email` and `password`",
+				Optional:            true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded CA certificate trusted in addition to the system roots when connecting to Spade. Conflicts with `ca_cert_file`",
+				Optional:            true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM-encoded CA certificate trusted in addition to the system roots when connecting to Spade. Conflicts with `ca_cert_pem`",
+				Optional:            true,
+			},
+			"client_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded client certificate for mutual TLS, required with `client_key`",
+				Optional:            true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM-encoded private key of `client_cert`",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip verification of the Spade server certificate. Only use this for testing",
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy used to reach Spade, e.g. `http://proxy.example.com:3128`. Defaults to the `HTTPS_PROXY`/`HTTP_PROXY` environment variables",
+				Optional:            true,
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "Additional HTTP headers sent with every request, e.g. for an API gateway in front of Spade",
+				ElementType:         types.StringType,
+				Optional:            true,
 				Sensitive:           true,
 			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "Timeout for a single request as a duration, e.g. `30s`. Defaults to no timeout",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Number of times a request failing with a transient error (429, 502, 503, 504 or a dropped connection) is retried. Defaults to `%d`", defaultMaxRetries),
+				Optional:            true,
+			},
+			"retry_min_wait": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Minimum wait between retries as a duration, e.g. `500ms`. Defaults to `%s`", defaultRetryMinWait),
+				Optional:            true,
+			},
+			"retry_max_wait": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Maximum wait between retries as a duration, e.g. `1m`. Also caps waits requested by the server via `Retry-After`. Defaults to `%s`", defaultRetryMaxWait),
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -73,24 +156,189 @@ func (p *SpadeProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		return
 	}
 
-	// Configuration values are now available.
-	// if data.Endpoint.IsNull() { /* ... */ }
+	for _, setting := range []struct {
+		name  string
+		value attr.Value
+		env   string
+	}{
+		{"url", data.URL, "SPADE_URL"},
+		{"email", data.Email, "SPADE_EMAIL"},
+		{"password", data.Password, "SPADE_PASSWORD"},
+		{"token", data.Token, "SPADE_TOKEN"},
+		{"token_file", data.TokenFile, ""},
+		{"ca_cert_pem", data.CACertPEM, ""},
+		{"ca_cert_file", data.CACertFile, ""},
+		{"client_cert", data.ClientCert, ""},
+		{"client_key", data.ClientKey, ""},
+		{"insecure_skip_verify", data.InsecureSkipVerify, ""},
+		{"proxy_url", data.ProxyURL, ""},
+		{"headers", data.Headers, ""},
+		{"request_timeout", data.RequestTimeout, ""},
+		{"max_retries", data.MaxRetries, ""},
+		{"retry_min_wait", data.RetryMinWait, ""},
+		{"retry_max_wait", data.RetryMaxWait, ""},
+	} {
+		if setting.value.IsUnknown() {
+			addUnknownConfigError(&resp.Diagnostics, path.Root(setting.name), setting.env)
+		}
+	}
+	for name, value := range data.Headers.Elements() {
+		if value.IsUnknown() {
+			addUnknownConfigError(&resp.Diagnostics, path.Root("headers").AtMapKey(name), "")
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// Example client configuration for data sources and resource
-	client := &spade.SpadeClient{
-		ApiUrl:     data.URL.ValueString(),
-		HttpClient: http.DefaultClient,
+	url := stringValueOrEnv(data.URL, "SPADE_URL")
+	email := stringValueOrEnv(data.Email, "SPADE_EMAIL")
+	password := stringValueOrEnv(data.Password, "SPADE_PASSWORD")
+	token := data.Token.ValueString()
+	if data.Email.IsNull() && data.TokenFile.IsNull() && token == "" {
+		token = os.Getenv("SPADE_TOKEN")
 	}
-	err := client.Login(data.Email.ValueString(), data.Password.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Login Error", fmt.Sprintf("Login failed: %s", err))
+
+	if url == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("url"),
+			"Missing Spade URL",
+			"Set the url attribute in the provider configuration or the SPADE_URL environment variable.",
+		)
+	}
+	if token == "" && data.TokenFile.IsNull() {
+		if email == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("email"),
+				"Missing Spade Credentials",
+				"Set either email and password (SPADE_EMAIL and SPADE_PASSWORD), or token (SPADE_TOKEN) or token_file in the provider configuration.",
+			)
+		}
+		if email != "" && password == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("password"),
+				"Missing Spade Password",
+				"Set the password attribute in the provider configuration or the SPADE_PASSWORD environment variable.",
+			)
+		}
+	}
+
+	maxRetries := int64(defaultMaxRetries)
+	if !data.MaxRetries.IsNull() {
+		maxRetries = data.MaxRetries.ValueInt64()
+		if maxRetries < 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("max_retries"), "Invalid Retry Count", "max_retries must not be negative")
+		}
+	}
+	retryMinWait := parseDuration(data.RetryMinWait, path.Root("retry_min_wait"), defaultRetryMinWait, &resp.Diagnostics)
+	retryMaxWait := parseDuration(data.RetryMaxWait, path.Root("retry_max_wait"), defaultRetryMaxWait, &resp.Diagnostics)
+	if retryMinWait > retryMaxWait {
+		resp.Diagnostics.AddAttributeError(path.Root("retry_min_wait"), "Invalid Duration", "retry_min_wait must not be greater than retry_max_wait")
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpClient := newHTTPClient(data, &resp.Diagnostics)
+	headers := map[string]string{}
+	resp.Diagnostics.Append(data.Headers.ElementsAs(ctx, &headers, false)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	tflog.Info(ctx, "Logged in to Spade")
+
+	client := &spade.SpadeClient{
+		ApiUrl:       url,
+		HttpClient:   httpClient,
+		Headers:      headers,
+		MaxRetries:   int(maxRetries),
+		RetryMinWait: retryMinWait,
+		RetryMaxWait: retryMaxWait,
+	}
+	switch {
+	case token != "":
+		client.Token = token
+		tflog.Info(ctx, "Using API token for Spade")
+	case !data.TokenFile.IsNull():
+		token, err := os.ReadFile(data.TokenFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("token_file"), "Token File Error", fmt.Sprintf("Unable to read token file: %s", err))
+			return
+		}
+		client.Token = strings.TrimSpace(string(token))
+		tflog.Info(ctx, "Using API token file for Spade")
+	default:
+		err := client.Login(ctx, email, password)
+		if err != nil {
+			resp.Diagnostics.AddError("Login Error", fmt.Sprintf("Login failed: %s", err))
+			return
+		}
+		tflog.Info(ctx, "Logged in to Spade")
+	}
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
 
+func (p *SpadeProvider) ConfigValidators(ctx context.Context) []provider.ConfigValidator {
+	return []provider.ConfigValidator{
+		// credentials may also come from the environment, so only reject
+		// configurations mixing authentication methods here
+		providervalidator.Conflicting(
+			path.MatchRoot("email"),
+			path.MatchRoot("token"),
+			path.MatchRoot("token_file"),
+		),
+		providervalidator.Conflicting(
+			path.MatchRoot("password"),
+			path.MatchRoot("token"),
+			path.MatchRoot("token_file"),
+		),
+		providervalidator.Conflicting(
+			path.MatchRoot("ca_cert_pem"),
+			path.MatchRoot("ca_cert_file"),
+		),
+		providervalidator.RequiredTogether(
+			path.MatchRoot("client_cert"),
+			path.MatchRoot("client_key"),
+		),
+	}
+}
+
+// addUnknownConfigError reports that the provider cannot be configured as the
+// attribute at attributePath is unknown, pointing at env when the attribute can
+// also be set from the environment.
+func addUnknownConfigError(diags *diag.Diagnostics, attributePath path.Path, env string) {
+	detail := fmt.Sprintf("The provider cannot be configured as %s is unknown. Set it to a static value.", attributePath)
+	if env != "" {
+		detail = fmt.Sprintf("The provider cannot be configured as %s is unknown. Set it to a static value or use the %s environment variable.", attributePath, env)
+	}
+	diags.AddAttributeError(attributePath, "Unknown Spade Provider Configuration", detail)
+}
+
+// stringValueOrEnv returns the configured value, falling back to the
+// environment variable env if the attribute is not set.
+func stringValueOrEnv(value types.String, env string) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+	return os.Getenv(env)
+}
+
+// parseDuration parses a duration attribute, returning def if it is not set.
+func parseDuration(value types.String, attrPath path.Path, def time.Duration, diags *diag.Diagnostics) time.Duration {
+	if value.IsNull() {
+		return def
+	}
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(attrPath, "Invalid Duration", fmt.Sprintf("Unable to parse duration %q: %s", value.ValueString(), err))
+		return def
+	}
+	if d < 0 {
+		diags.AddAttributeError(attrPath, "Invalid Duration", "Duration must not be negative")
+	}
+	return d
+}
+
 func (p *SpadeProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewSpadeExecutorResource,
@@ -103,12 +351,28 @@ func (p *SpadeProvider) Resources(ctx context.Context) []func() resource.Resourc
 		NewSpadeVariableResource,
 		NewSpadeSecretVariableResource,
 		NewSpadeVariableSetResource,
+		NewSpadeGroupMembershipResource,
+		NewSpadeVariableSetMemberResource,
+		NewSpadeProcessVariableSetAttachmentResource,
+		NewSpadeFileVariableSetAttachmentResource,
 	}
 }
 
 func (p *SpadeProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewSpadeVariableDataSource,
+		NewSpadeExecutorDataSource,
+		NewSpadeFileProcessorDataSource,
+		NewSpadeFileFormatDataSource,
+		NewSpadeProcessDataSource,
+		NewSpadeFileDataSource,
+		NewSpadeProcessesDataSource,
+		NewSpadeFilesDataSource,
+		NewSpadeExecutorsDataSource,
+		NewSpadeFileProcessorsDataSource,
+		NewSpadeUserDataSource,
+		NewSpadeGroupDataSource,
+		NewSpadeUsersDataSource,
 	}
 }
 
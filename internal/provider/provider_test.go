@@ -0,0 +1,465 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	spade "terraform-provider-spade/internal/client"
+	"terraform-provider-spade/internal/spadetest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testAccProtoV6ProviderFactories are used to instantiate a provider during
+// acceptance testing. The factory function will be invoked for every Terraform
+// CLI command executed to create a provider server to which the CLI can
+// reattach.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"spade": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// testAccServer is the in-memory Spade API the acceptance tests run against
+// when SPADE_URL is not set. It is nil when testing against a live Spade.
+var testAccServer *spadetest.Server
+
+func TestMain(m *testing.M) {
+	if os.Getenv("TF_ACC") != "" && os.Getenv("SPADE_URL") == "" {
+		testAccServer = spadetest.NewServer()
+		env := map[string]string{
+			"SPADE_URL":      testAccServer.URL,
+			"SPADE_EMAIL":    testAccServer.Email,
+			"SPADE_PASSWORD": testAccServer.Password,
+		}
+		for key, value := range env {
+			if err := os.Setenv(key, value); err != nil {
+				log.Fatal(err)
+			}
+		}
+		if err := os.Unsetenv("SPADE_TOKEN"); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	code := m.Run()
+
+	if testAccServer != nil {
+		testAccServer.Close()
+	}
+	os.Exit(code)
+}
+
+func testAccPreCheck(t *testing.T) {
+	if os.Getenv("SPADE_URL") == "" {
+		t.Fatal("SPADE_URL must be set for acceptance tests")
+	}
+	if os.Getenv("SPADE_TOKEN") == "" && (os.Getenv("SPADE_EMAIL") == "" || os.Getenv("SPADE_PASSWORD") == "") {
+		t.Fatal("SPADE_EMAIL and SPADE_PASSWORD, or SPADE_TOKEN, must be set for acceptance tests")
+	}
+}
+
+// testAccClient returns a client for the Spade the acceptance tests run
+// against, used to make changes outside of Terraform.
+func testAccClient(t *testing.T) *spade.SpadeClient {
+	t.Helper()
+
+	c := &spade.SpadeClient{
+		ApiUrl:     os.Getenv("SPADE_URL"),
+		HttpClient: http.DefaultClient,
+		Token:      os.Getenv("SPADE_TOKEN"),
+	}
+	if c.Token == "" {
+		err := c.Login(context.Background(), os.Getenv("SPADE_EMAIL"), os.Getenv("SPADE_PASSWORD"))
+		if err != nil {
+			t.Fatalf("unable to login to Spade: %s", err)
+		}
+	}
+	return c
+}
+
+// testAccPreCheckTestServer skips tests which change objects in ways the API
+// does not allow, and so only run against the in-memory Spade API.
+func testAccPreCheckTestServer(t *testing.T) {
+	if testAccServer == nil {
+		t.Skip("only supported against the in-memory Spade API, unset SPADE_URL to run")
+	}
+}
+
+// testAccCheckResourceId stores the identifier of a resource in id, so that
+// following steps can act on the object.
+func testAccCheckResourceId(name string, id *int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", name)
+		}
+		value, err := strconv.ParseInt(rs.Primary.ID, 10, 64)
+		if err != nil {
+			return fmt.Errorf("unexpected identifier for %s: %s", name, rs.Primary.ID)
+		}
+		*id = value
+		return nil
+	}
+}
+
+// testAccDeleteOutOfBand returns a PreConfig function deleting the object
+// with identifier id, as if it was removed outside of Terraform.
+func testAccDeleteOutOfBand(t *testing.T, id *int64, deleteFunc func(*spade.SpadeClient, context.Context, int64) error) func() {
+	return func() {
+		if err := deleteFunc(testAccClient(t), context.Background(), *id); err != nil {
+			t.Fatalf("unable to delete object %d: %s", *id, err)
+		}
+	}
+}
+
+// testProviderConfigure configures the provider with the given attributes,
+// leaving the others null, and returns the client it created. The SPADE_
+// environment variables are read as set: callers clear them with
+// testProviderUnsetEnv first, then set the ones they need.
+func testProviderConfigure(t *testing.T, values map[string]tftypes.Value) (*spade.SpadeClient, diag.Diagnostics) {
+	t.Helper()
+
+	ctx := context.Background()
+	p := New("test")()
+	config := testProviderConfig(t, p, values)
+	resp := provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: config}, &resp)
+
+	client, _ := resp.ResourceData.(*spade.SpadeClient)
+	return client, resp.Diagnostics
+}
+
+// testProviderValidate runs the config validators of the provider against the
+// given attributes, leaving the others null.
+func testProviderValidate(t *testing.T, values map[string]tftypes.Value) diag.Diagnostics {
+	t.Helper()
+
+	ctx := context.Background()
+	p := New("test")()
+	config := testProviderConfig(t, p, values)
+	var diags diag.Diagnostics
+	for _, validator := range p.(provider.ProviderWithConfigValidators).ConfigValidators(ctx) {
+		resp := provider.ValidateConfigResponse{}
+		validator.ValidateProvider(ctx, provider.ValidateConfigRequest{Config: config}, &resp)
+		diags.Append(resp.Diagnostics...)
+	}
+	return diags
+}
+
+func testProviderConfig(t *testing.T, p provider.Provider, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+
+	ctx := context.Background()
+	schemaResp := provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range values {
+		if _, ok := attributes[name]; !ok {
+			t.Fatalf("unknown provider attribute: %s", name)
+		}
+		attributes[name] = value
+	}
+	return tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+}
+
+// testProviderUnsetEnv clears the environment variables read by the provider
+// for the duration of the test.
+func testProviderUnsetEnv(t *testing.T) {
+	for _, env := range []string{"SPADE_URL", "SPADE_EMAIL", "SPADE_PASSWORD", "SPADE_TOKEN"} {
+		t.Setenv(env, "")
+	}
+}
+
+func TestProviderConfigureToken(t *testing.T) {
+	testProviderUnsetEnv(t)
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("file-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name     string
+		values   map[string]tftypes.Value
+		envToken string
+		expected string
+	}{
+		{
+			name:     "token",
+			values:   map[string]tftypes.Value{"token": tftypes.NewValue(tftypes.String, "config-token")},
+			expected: "config-token",
+		},
+		{
+			name:     "token file",
+			values:   map[string]tftypes.Value{"token_file": tftypes.NewValue(tftypes.String, tokenFile)},
+			expected: "file-token",
+		},
+		{
+			name:     "environment",
+			envToken: "env-token",
+			expected: "env-token",
+		},
+		{
+			name:     "token over environment",
+			values:   map[string]tftypes.Value{"token": tftypes.NewValue(tftypes.String, "config-token")},
+			envToken: "env-token",
+			expected: "config-token",
+		},
+		{
+			name:     "token file over environment",
+			values:   map[string]tftypes.Value{"token_file": tftypes.NewValue(tftypes.String, tokenFile)},
+			envToken: "env-token",
+			expected: "file-token",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("SPADE_TOKEN", tc.envToken)
+			// SPADE_TOKEN takes precedence over SPADE_EMAIL, so no login
+			// is attempted with these
+			t.Setenv("SPADE_EMAIL", "tf_acc@example.com")
+			t.Setenv("SPADE_PASSWORD", "wrong")
+
+			values := map[string]tftypes.Value{"url": tftypes.NewValue(tftypes.String, "http://spade.invalid")}
+			for name, value := range tc.values {
+				values[name] = value
+			}
+			client, diags := testProviderConfigure(t, values)
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+			if client.Token != tc.expected {
+				t.Errorf("expected token %q, got %q", tc.expected, client.Token)
+			}
+		})
+	}
+}
+
+func TestProviderConfigureEmailOverEnvToken(t *testing.T) {
+	testProviderUnsetEnv(t)
+	server := spadetest.NewServer()
+	defer server.Close()
+
+	t.Setenv("SPADE_TOKEN", "env-token")
+	t.Setenv("SPADE_PASSWORD", server.Password)
+	client, diags := testProviderConfigure(t, map[string]tftypes.Value{
+		"url":   tftypes.NewValue(tftypes.String, server.URL),
+		"email": tftypes.NewValue(tftypes.String, server.Email),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if client.Token == "env-token" {
+		t.Error("expected SPADE_TOKEN to be ignored when email is configured")
+	}
+}
+
+func TestProviderConfigureTokenFileMissing(t *testing.T) {
+	testProviderUnsetEnv(t)
+
+	_, diags := testProviderConfigure(t, map[string]tftypes.Value{
+		"url":        tftypes.NewValue(tftypes.String, "http://spade.invalid"),
+		"token_file": tftypes.NewValue(tftypes.String, filepath.Join(t.TempDir(), "missing")),
+	})
+	testCheckDiagnostic(t, diags, path.Root("token_file"), "Token File Error")
+}
+
+func TestProviderConflictingCredentials(t *testing.T) {
+	cases := []struct {
+		name     string
+		values   map[string]tftypes.Value
+		conflict bool
+	}{
+		{
+			name: "email and password",
+			values: map[string]tftypes.Value{
+				"email":    tftypes.NewValue(tftypes.String, "tf_acc@example.com"),
+				"password": tftypes.NewValue(tftypes.String, "secret"),
+			},
+		},
+		{
+			name: "email and token",
+			values: map[string]tftypes.Value{
+				"email": tftypes.NewValue(tftypes.String, "tf_acc@example.com"),
+				"token": tftypes.NewValue(tftypes.String, "token"),
+			},
+			conflict: true,
+		},
+		{
+			name: "password and token",
+			values: map[string]tftypes.Value{
+				"password": tftypes.NewValue(tftypes.String, "secret"),
+				"token":    tftypes.NewValue(tftypes.String, "token"),
+			},
+			conflict: true,
+		},
+		{
+			name: "password and token file",
+			values: map[string]tftypes.Value{
+				"password":   tftypes.NewValue(tftypes.String, "secret"),
+				"token_file": tftypes.NewValue(tftypes.String, "token"),
+			},
+			conflict: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := testProviderValidate(t, tc.values)
+			if diags.HasError() != tc.conflict {
+				t.Errorf("expected conflict %t, got: %v", tc.conflict, diags)
+			}
+		})
+	}
+}
+
+// testCheckDiagnostic checks that diags holds an error with the given summary
+// reported against attributePath.
+func testCheckDiagnostic(t *testing.T, diags diag.Diagnostics, attributePath path.Path, summary string) {
+	t.Helper()
+
+	for _, d := range diags.Errors() {
+		withPath, ok := d.(diag.DiagnosticWithPath)
+		if ok && withPath.Path().Equal(attributePath) && d.Summary() == summary {
+			return
+		}
+	}
+	t.Errorf("expected error %q for %s, got: %v", summary, attributePath, diags)
+}
+
+func TestProviderConfigureUnknown(t *testing.T) {
+	testProviderUnsetEnv(t)
+
+	ctx := context.Background()
+	schemaResp := provider.SchemaResponse{}
+	New("test")().Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	for name, attributeType := range objectType.AttributeTypes {
+		t.Run(name, func(t *testing.T) {
+			_, diags := testProviderConfigure(t, map[string]tftypes.Value{
+				name: tftypes.NewValue(attributeType, tftypes.UnknownValue),
+			})
+			testCheckDiagnostic(t, diags, path.Root(name), "Unknown Spade Provider Configuration")
+		})
+	}
+
+	t.Run("header", func(t *testing.T) {
+		_, diags := testProviderConfigure(t, map[string]tftypes.Value{
+			"headers": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"X-Tenant": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		})
+		testCheckDiagnostic(t, diags, path.Root("headers").AtMapKey("X-Tenant"), "Unknown Spade Provider Configuration")
+	})
+}
+
+func TestProviderConfigureMissing(t *testing.T) {
+	cases := []struct {
+		name    string
+		env     map[string]string
+		values  map[string]tftypes.Value
+		path    path.Path
+		summary string
+	}{
+		{
+			name:    "url",
+			env:     map[string]string{"SPADE_TOKEN": "token"},
+			path:    path.Root("url"),
+			summary: "Missing Spade URL",
+		},
+		{
+			name:    "credentials",
+			env:     map[string]string{"SPADE_URL": "http://spade.invalid"},
+			path:    path.Root("email"),
+			summary: "Missing Spade Credentials",
+		},
+		{
+			name:    "password from environment",
+			env:     map[string]string{"SPADE_URL": "http://spade.invalid", "SPADE_EMAIL": "tf_acc@example.com"},
+			path:    path.Root("password"),
+			summary: "Missing Spade Password",
+		},
+		{
+			name:    "password in configuration",
+			env:     map[string]string{"SPADE_URL": "http://spade.invalid"},
+			values:  map[string]tftypes.Value{"email": tftypes.NewValue(tftypes.String, "tf_acc@example.com")},
+			path:    path.Root("password"),
+			summary: "Missing Spade Password",
+		},
+		{
+			name:    "negative retries",
+			env:     map[string]string{"SPADE_URL": "http://spade.invalid", "SPADE_TOKEN": "token"},
+			values:  map[string]tftypes.Value{"max_retries": tftypes.NewValue(tftypes.Number, -1)},
+			path:    path.Root("max_retries"),
+			summary: "Invalid Retry Count",
+		},
+		{
+			name: "retry waits",
+			env:  map[string]string{"SPADE_URL": "http://spade.invalid", "SPADE_TOKEN": "token"},
+			values: map[string]tftypes.Value{
+				"retry_min_wait": tftypes.NewValue(tftypes.String, "1m"),
+				"retry_max_wait": tftypes.NewValue(tftypes.String, "1s"),
+			},
+			path:    path.Root("retry_min_wait"),
+			summary: "Invalid Duration",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			testProviderUnsetEnv(t)
+			for key, value := range tc.env {
+				t.Setenv(key, value)
+			}
+			_, diags := testProviderConfigure(t, tc.values)
+			testCheckDiagnostic(t, diags, tc.path, tc.summary)
+		})
+	}
+}
+
+func TestProviderConfigureHeaders(t *testing.T) {
+	testProviderUnsetEnv(t)
+	headers := make(chan http.Header, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers <- r.Header
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": 1, "name": "region", "value": "eu-west-1"}`)
+	}))
+	defer server.Close()
+
+	client, diags := testProviderConfigure(t, map[string]tftypes.Value{
+		"url":   tftypes.NewValue(tftypes.String, server.URL),
+		"token": tftypes.NewValue(tftypes.String, "token"),
+		"headers": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"X-Tenant": tftypes.NewValue(tftypes.String, "analytics"),
+		}),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if _, err := client.ReadVariable(context.Background(), 1); err != nil {
+		t.Fatalf("read failed: %s", err)
+	}
+	if tenant := (<-headers).Get("X-Tenant"); tenant != "analytics" {
+		t.Errorf("expected header X-Tenant to be sent as analytics, got %q", tenant)
+	}
+}
@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strconv"
 	spade "terraform-provider-spade/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -39,6 +38,10 @@ type SpadeExecutorResourceModel struct {
 	HistoryProviderCallable types.String `tfsdk:"history_provider_callable"`
 }
 
+// spadeExecutorFieldPaths maps the fields of executor requests to the
+// attributes they are set from.
+var spadeExecutorFieldPaths = sameFieldPaths("name", "description", "callable", "history_provider_callable")
+
 func (r *SpadeExecutorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_executor"
 }
@@ -111,13 +114,14 @@ func (r *SpadeExecutorResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	spadeResp, err := r.Client.CreateExecutor(
+		ctx,
 		data.Name.ValueString(),
 		data.Description.ValueString(),
 		data.Callable.ValueString(),
 		data.HistoryProviderCallable.ValueString(),
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create executor, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create executor", err, spadeExecutorFieldPaths)
 		return
 	}
 
@@ -142,9 +146,9 @@ func (r *SpadeExecutorResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	spadeResp, err := r.Client.ReadExecutor(data.Id.ValueInt64())
+	spadeResp, err := r.Client.ReadExecutor(ctx, data.Id.ValueInt64())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read executor, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to read executor", err, nil)
 		return
 	}
 	if spadeResp == nil {
@@ -175,6 +179,7 @@ func (r *SpadeExecutorResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	spadeResp, err := r.Client.UpdateExecutor(
+		ctx,
 		data.Id.ValueInt64(),
 		data.Name.ValueString(),
 		data.Description.ValueString(),
@@ -182,7 +187,7 @@ func (r *SpadeExecutorResource) Update(ctx context.Context, req resource.UpdateR
 		data.HistoryProviderCallable.ValueString(),
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update executor, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to update executor", err, spadeExecutorFieldPaths)
 		return
 	}
 
@@ -207,20 +212,22 @@ func (r *SpadeExecutorResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	err := r.Client.DeleteExecutor(data.Id.ValueInt64())
+	err := r.Client.DeleteExecutor(ctx, data.Id.ValueInt64())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete executor, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete executor", err, nil)
 		return
 	}
 }
 
 func (r *SpadeExecutorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id, err := strconv.ParseInt(req.ID, 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected a numeric resource ID, got: %s", req.ID),
-		)
+	id, ok := importId(ctx, req.ID, "name", func(ctx context.Context, name string) (int64, error) {
+		executor, err := r.Client.SearchExecutor(ctx, name)
+		if err != nil {
+			return 0, err
+		}
+		return executor.Id, nil
+	}, &resp.Diagnostics)
+	if !ok {
 		return
 	}
 	resp.Diagnostics.Append(
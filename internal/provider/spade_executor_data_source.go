@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	spade "terraform-provider-spade/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SpadeExecutorDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &SpadeExecutorDataSource{}
+
+func NewSpadeExecutorDataSource() datasource.DataSource {
+	return &SpadeExecutorDataSource{}
+}
+
+// SpadeExecutorDataSource defines the data source implementation.
+type SpadeExecutorDataSource struct {
+	Client *spade.SpadeClient
+}
+
+// SpadeExecutorDataSourceModel describes the data source data model.
+type SpadeExecutorDataSourceModel struct {
+	Id                      types.Int64  `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	Description             types.String `tfsdk:"description"`
+	Callable                types.String `tfsdk:"callable"`
+	HistoryProviderCallable types.String `tfsdk:"history_provider_callable"`
+}
+
+func (d *SpadeExecutorDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_executor"
+}
+
+func (d *SpadeExecutorDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Executor data source, looked up by name or id",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Identifier of the executor",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the executor",
+				Optional:            true,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the executor",
+				Computed:            true,
+			},
+			"callable": schema.StringAttribute{
+				MarkdownDescription: "Python import path to the Executor class",
+				Computed:            true,
+			},
+			"history_provider_callable": schema.StringAttribute{
+				MarkdownDescription: "Python import path to the HistoryProvider class",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SpadeExecutorDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *SpadeExecutorDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*spade.SpadeClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spade.SpadeClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.Client = client
+}
+
+func (d *SpadeExecutorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SpadeExecutorDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var spadeResp *spade.SpadeExecutorReadResponse
+	var err error
+	if !data.Id.IsNull() {
+		spadeResp, err = d.Client.ReadExecutor(ctx, data.Id.ValueInt64())
+		if err == nil && spadeResp == nil {
+			err = fmt.Errorf("cannot find executor with id: %d", data.Id.ValueInt64())
+		}
+	} else {
+		spadeResp, err = d.Client.SearchExecutor(ctx, data.Name.ValueString())
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to find executor", err, nil)
+		return
+	}
+
+	// Update the model with the response data
+	data.Id = types.Int64Value(spadeResp.Id)
+	data.Name = types.StringValue(spadeResp.Name)
+	data.Description = types.StringValue(spadeResp.Description)
+	data.Callable = types.StringValue(spadeResp.Callable)
+	data.HistoryProviderCallable = types.StringValue(spadeResp.HistoryProviderCallable)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSpadeExecutorDataSource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf_acc_executor")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Lookup of a missing executor
+			{
+				Config:      `data "spade_executor" "test" { name = "tf_acc_missing" }`,
+				ExpectError: regexp.MustCompile(`cannot find executor with name:\s+tf_acc_missing`),
+			},
+			// Read testing
+			{
+				Config: testAccSpadeExecutorDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.spade_executor.by_name", "id", "spade_executor.test", "id"),
+					resource.TestCheckResourceAttr("data.spade_executor.by_name", "name", name),
+					resource.TestCheckResourceAttr("data.spade_executor.by_name", "description", "Runs locally"),
+					resource.TestCheckResourceAttr("data.spade_executor.by_name", "callable", "executors.Local"),
+					resource.TestCheckResourceAttr("data.spade_executor.by_name", "history_provider_callable", "executors.LocalHistory"),
+					resource.TestCheckResourceAttr("data.spade_executor.by_id", "name", name),
+				),
+			},
+		},
+	})
+}
+
+func testAccSpadeExecutorDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "spade_executor" "test" {
+  name                      = %[1]q
+  description               = "Runs locally"
+  callable                  = "executors.Local"
+  history_provider_callable = "executors.LocalHistory"
+}
+
+data "spade_executor" "by_name" {
+  name = spade_executor.test.name
+}
+
+data "spade_executor" "by_id" {
+  id = spade_executor.test.id
+}
+`, name)
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	spade "terraform-provider-spade/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccSpadeExecutorResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf_acc_executor")
+	var id int64
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSpadeExecutorResourceConfig(name, "executors.Local"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("spade_executor.test", "name", name),
+					resource.TestCheckResourceAttr("spade_executor.test", "description", ""),
+					resource.TestCheckResourceAttr("spade_executor.test", "callable", "executors.Local"),
+					resource.TestCheckResourceAttr("spade_executor.test", "history_provider_callable", "executors.LocalHistory"),
+					resource.TestCheckResourceAttrSet("spade_executor.test", "id"),
+					testAccCheckResourceId("spade_executor.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "spade_executor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing by natural key
+			{
+				ResourceName:      "spade_executor.test",
+				ImportState:       true,
+				ImportStateId:     "name:" + name,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSpadeExecutorResourceConfig(name, "executors.Remote"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_executor.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("spade_executor.test", "callable", "executors.Remote"),
+				),
+			},
+			// Recreate after deletion outside of Terraform
+			{
+				PreConfig: testAccDeleteOutOfBand(t, &id, (*spade.SpadeClient).DeleteExecutor),
+				Config:    testAccSpadeExecutorResourceConfig(name, "executors.Remote"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_executor.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSpadeExecutorResourceConfig(name, callable string) string {
+	return fmt.Sprintf(`
+resource "spade_executor" "test" {
+  name                      = %[1]q
+  callable                  = %[2]q
+  history_provider_callable = "executors.LocalHistory"
+}
+`, name, callable)
+}
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	spade "terraform-provider-spade/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SpadeExecutorsDataSource{}
+
+func NewSpadeExecutorsDataSource() datasource.DataSource {
+	return &SpadeExecutorsDataSource{}
+}
+
+// SpadeExecutorsDataSource defines the data source implementation.
+type SpadeExecutorsDataSource struct {
+	Client *spade.SpadeClient
+}
+
+// SpadeExecutorsDataSourceModel describes the data source data model.
+type SpadeExecutorsDataSourceModel struct {
+	NamePrefix types.String                   `tfsdk:"name_prefix"`
+	NameRegex  types.String                   `tfsdk:"name_regex"`
+	Executors  []SpadeExecutorDataSourceModel `tfsdk:"executors"`
+}
+
+func (d *SpadeExecutorsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_executors"
+}
+
+func (d *SpadeExecutorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the executors matching all of the given filters",
+
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only include executors whose name starts with this prefix",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only include executors whose name matches this regular expression (RE2 syntax)",
+				Optional:            true,
+			},
+			"executors": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching executors, ordered by identifier",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Identifier of the executor",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the executor",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the executor",
+							Computed:            true,
+						},
+						"callable": schema.StringAttribute{
+							MarkdownDescription: "Python import path to the Executor class",
+							Computed:            true,
+						},
+						"history_provider_callable": schema.StringAttribute{
+							MarkdownDescription: "Python import path to the HistoryProvider class",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SpadeExecutorsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*spade.SpadeClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spade.SpadeClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.Client = client
+}
+
+func (d *SpadeExecutorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SpadeExecutorsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameFilter := newNameFilter(data.NamePrefix, data.NameRegex, path.Root("name_regex"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spadeResp, err := d.Client.ListExecutors(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to list executors", err, nil)
+		return
+	}
+
+	sort.Slice(spadeResp, func(i, j int) bool { return spadeResp[i].Id < spadeResp[j].Id })
+
+	// Update the model with the matching executors
+	data.Executors = []SpadeExecutorDataSourceModel{}
+	for _, executor := range spadeResp {
+		if !nameFilter.matches(executor.Name) {
+			continue
+		}
+		data.Executors = append(data.Executors, SpadeExecutorDataSourceModel{
+			Id:                      types.Int64Value(executor.Id),
+			Name:                    types.StringValue(executor.Name),
+			Description:             types.StringValue(executor.Description),
+			Callable:                types.StringValue(executor.Callable),
+			HistoryProviderCallable: types.StringValue(executor.HistoryProviderCallable),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSpadeExecutorsDataSource(t *testing.T) {
+	prefix := acctest.RandomWithPrefix("tf_acc_executors")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccSpadeExecutorsDataSourceConfig(prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.spade_executors.prefix", "executors.#", "2"),
+					resource.TestCheckResourceAttrPair("data.spade_executors.prefix", "executors.0.id", "spade_executor.local", "id"),
+					resource.TestCheckResourceAttr("data.spade_executors.prefix", "executors.0.callable", "executors.Local"),
+					resource.TestCheckResourceAttrPair("data.spade_executors.prefix", "executors.1.id", "spade_executor.remote", "id"),
+					resource.TestCheckResourceAttr("data.spade_executors.regex", "executors.#", "1"),
+					resource.TestCheckResourceAttr("data.spade_executors.regex", "executors.0.name", prefix+"-remote"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSpadeExecutorsDataSourceConfig(prefix string) string {
+	return fmt.Sprintf(`
+resource "spade_executor" "local" {
+  name     = "%[1]s-local"
+  callable = "executors.Local"
+}
+
+resource "spade_executor" "remote" {
+  name     = "%[1]s-remote"
+  callable = "executors.Remote"
+
+  # created second so that it is listed second
+  depends_on = [spade_executor.local]
+}
+
+data "spade_executors" "prefix" {
+  name_prefix = "%[1]s-"
+  depends_on  = [spade_executor.local, spade_executor.remote]
+}
+
+data "spade_executors" "regex" {
+  name_regex = "^%[1]s-rem"
+  depends_on = [spade_executor.local, spade_executor.remote]
+}
+`, prefix)
+}
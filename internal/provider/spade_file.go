@@ -7,7 +7,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strconv"
 	spade "terraform-provider-spade/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
@@ -49,6 +48,10 @@ type SpadeFileResourceModel struct {
 	VariableSets  types.Set            `tfsdk:"variable_sets"`
 }
 
+// spadeFileFieldPaths maps the fields of file requests to the attributes they
+// are set from.
+var spadeFileFieldPaths = sameFieldPaths("code", "description", "tags", "format", "processor", "system_params", "user_params", "linked_process", "variable_sets")
+
 func (r *SpadeFileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_file"
 }
@@ -185,6 +188,7 @@ func (r *SpadeFileResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	spadeResp, err := r.Client.CreateFile(
+		ctx,
 		data.Code.ValueString(),
 		data.Description.ValueString(),
 		tagStrings,
@@ -196,7 +200,7 @@ func (r *SpadeFileResource) Create(ctx context.Context, req resource.CreateReque
 		variableSetIDs,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create file, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create file", err, spadeFileFieldPaths)
 		return
 	}
 
@@ -251,9 +255,9 @@ func (r *SpadeFileResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	spadeResp, err := r.Client.ReadFile(data.Id.ValueInt64())
+	spadeResp, err := r.Client.ReadFile(ctx, data.Id.ValueInt64())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read file, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to read file", err, nil)
 		return
 	}
 	if spadeResp == nil {
@@ -346,7 +350,10 @@ func (r *SpadeFileResource) Update(ctx context.Context, req resource.UpdateReque
 		variableSetIDs[i] = id.ValueInt64()
 	}
 
+	spadeMutexKV.Lock(fileMutexKey(data.Id.ValueInt64()))
+	defer spadeMutexKV.Unlock(fileMutexKey(data.Id.ValueInt64()))
 	spadeResp, err := r.Client.UpdateFile(
+		ctx,
 		data.Id.ValueInt64(),
 		data.Code.ValueString(),
 		data.Description.ValueString(),
@@ -359,7 +366,7 @@ func (r *SpadeFileResource) Update(ctx context.Context, req resource.UpdateReque
 		variableSetIDs,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update file, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to update file", err, spadeFileFieldPaths)
 		return
 	}
 
@@ -414,20 +421,22 @@ func (r *SpadeFileResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	err := r.Client.DeleteFile(data.Id.ValueInt64())
+	err := r.Client.DeleteFile(ctx, data.Id.ValueInt64())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete file, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete file", err, nil)
 		return
 	}
 }
 
 func (r *SpadeFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id, err := strconv.ParseInt(req.ID, 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected a numeric resource ID, got: %s", req.ID),
-		)
+	id, ok := importId(ctx, req.ID, "code", func(ctx context.Context, code string) (int64, error) {
+		file, err := r.Client.SearchFile(ctx, code)
+		if err != nil {
+			return 0, err
+		}
+		return file.Id, nil
+	}, &resp.Diagnostics)
+	if !ok {
 		return
 	}
 	resp.Diagnostics.Append(
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	spade "terraform-provider-spade/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SpadeFileDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &SpadeFileDataSource{}
+
+func NewSpadeFileDataSource() datasource.DataSource {
+	return &SpadeFileDataSource{}
+}
+
+// SpadeFileDataSource defines the data source implementation.
+type SpadeFileDataSource struct {
+	Client *spade.SpadeClient
+}
+
+// SpadeFileDataSourceModel describes the data source data model.
+type SpadeFileDataSourceModel struct {
+	Id            types.Int64          `tfsdk:"id"`
+	Code          types.String         `tfsdk:"code"`
+	Description   types.String         `tfsdk:"description"`
+	Tags          types.Set            `tfsdk:"tags"`
+	Format        types.Int64          `tfsdk:"format"`
+	Processor     types.Int64          `tfsdk:"processor"`
+	SystemParams  jsontypes.Normalized `tfsdk:"system_params"`
+	UserParams    jsontypes.Normalized `tfsdk:"user_params"`
+	LinkedProcess types.Int64          `tfsdk:"linked_process"`
+	VariableSets  types.Set            `tfsdk:"variable_sets"`
+}
+
+func (d *SpadeFileDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file"
+}
+
+func (d *SpadeFileDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "File data source, looked up by code or id",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Identifier of the file",
+				Optional:            true,
+				Computed:            true,
+			},
+			"code": schema.StringAttribute{
+				MarkdownDescription: "Name of the file",
+				Optional:            true,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the file",
+				Computed:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tags for the file",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"format": schema.Int64Attribute{
+				MarkdownDescription: "Identifier for file format",
+				Computed:            true,
+			},
+			"processor": schema.Int64Attribute{
+				MarkdownDescription: "Identifier for file processor",
+				Computed:            true,
+			},
+			"system_params": schema.StringAttribute{
+				MarkdownDescription: "JSON of system parameters",
+				CustomType:          jsontypes.NormalizedType{},
+				Computed:            true,
+			},
+			"user_params": schema.StringAttribute{
+				MarkdownDescription: "JSON of user parameters (JsonSchema form)",
+				CustomType:          jsontypes.NormalizedType{},
+				Computed:            true,
+			},
+			"linked_process": schema.Int64Attribute{
+				MarkdownDescription: "Identifier for linked process",
+				Computed:            true,
+			},
+			"variable_sets": schema.SetAttribute{
+				MarkdownDescription: "Variable set identifiers",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SpadeFileDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("code"),
+		),
+	}
+}
+
+func (d *SpadeFileDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*spade.SpadeClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spade.SpadeClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.Client = client
+}
+
+func (d *SpadeFileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SpadeFileDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var spadeResp *spade.SpadeFileReadResponse
+	var err error
+	if !data.Id.IsNull() {
+		spadeResp, err = d.Client.ReadFile(ctx, data.Id.ValueInt64())
+		if err == nil && spadeResp == nil {
+			err = fmt.Errorf("cannot find file with id: %d", data.Id.ValueInt64())
+		}
+	} else {
+		spadeResp, err = d.Client.SearchFile(ctx, data.Code.ValueString())
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to find file", err, nil)
+		return
+	}
+
+	// Update the model with the response data
+	data, diags := newSpadeFileDataSourceModel(ctx, spadeResp)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// newSpadeFileDataSourceModel converts a file returned by the Spade API
+// into the data source model.
+func newSpadeFileDataSourceModel(ctx context.Context, file *spade.SpadeFileReadResponse) (SpadeFileDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	data := SpadeFileDataSourceModel{
+		Id:          types.Int64Value(file.Id),
+		Code:        types.StringValue(file.Code),
+		Description: types.StringValue(file.Description),
+		Format:      types.Int64Value(file.Format),
+		Processor:   types.Int64Value(file.Processor),
+	}
+	data.LinkedProcess = types.Int64Value(file.LinkedProcess)
+	if file.LinkedProcess == 0 {
+		data.LinkedProcess = basetypes.NewInt64Null()
+	}
+
+	tags, d := basetypes.NewSetValueFrom(ctx, types.StringType, file.Tags)
+	diags.Append(d...)
+	data.Tags = tags
+	variableSets, d := basetypes.NewSetValueFrom(ctx, types.Int64Type, file.VariableSets)
+	diags.Append(d...)
+	data.VariableSets = variableSets
+
+	systemParams, err := json.Marshal(file.SystemParams)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to marshal system_params, got error: %s", err))
+		return data, diags
+	}
+	data.SystemParams = jsontypes.NewNormalizedValue(string(systemParams))
+	userParams, err := json.Marshal(file.UserParams)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to marshal user_params, got error: %s", err))
+		return data, diags
+	}
+	data.UserParams = jsontypes.NewNormalizedValue(string(userParams))
+
+	return data, diags
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSpadeFileDataSource(t *testing.T) {
+	code := acctest.RandomWithPrefix("tf_acc_file")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccSpadeFileDataSourceConfig(code),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.spade_file.by_code", "id", "spade_file.test", "id"),
+					resource.TestCheckResourceAttr("data.spade_file.by_code", "code", code),
+					resource.TestCheckResourceAttr("data.spade_file.by_code", "tags.#", "2"),
+					resource.TestCheckResourceAttrPair("data.spade_file.by_code", "format", "spade_file_format.test", "id"),
+					resource.TestCheckResourceAttrPair("data.spade_file.by_code", "processor", "spade_file_processor.test", "id"),
+					resource.TestCheckResourceAttr("data.spade_file.by_code", "system_params", "{}"),
+					resource.TestCheckResourceAttr("data.spade_file.by_code", "user_params", `{"type":"object"}`),
+					resource.TestCheckResourceAttrPair("data.spade_file.by_code", "linked_process", "spade_process.test", "id"),
+					resource.TestCheckTypeSetElemAttrPair("data.spade_file.by_code", "variable_sets.*", "spade_variable_set.test", "id"),
+					resource.TestCheckResourceAttr("data.spade_file.by_id", "code", code),
+				),
+			},
+		},
+	})
+}
+
+func testAccSpadeFileDataSourceConfig(code string) string {
+	return fmt.Sprintf(`
+resource "spade_file_format" "test" {
+  format = "%[1]s-csv"
+}
+
+resource "spade_file_processor" "test" {
+  name     = "%[1]s-processor"
+  callable = "processors.CSVProcessor"
+}
+
+resource "spade_executor" "test" {
+  name     = "%[1]s-executor"
+  callable = "executors.Local"
+}
+
+resource "spade_process" "test" {
+  code     = "%[1]s-process"
+  executor = spade_executor.test.id
+}
+
+resource "spade_variable_set" "test" {
+  name = "%[1]s-variables"
+}
+
+resource "spade_file" "test" {
+  code           = %[1]q
+  tags           = ["finance", "monthly"]
+  format         = spade_file_format.test.id
+  processor      = spade_file_processor.test.id
+  user_params    = jsonencode({ type = "object" })
+  linked_process = spade_process.test.id
+  variable_sets  = [spade_variable_set.test.id]
+}
+
+data "spade_file" "by_code" {
+  code = spade_file.test.code
+}
+
+data "spade_file" "by_id" {
+  id = spade_file.test.id
+}
+`, code)
+}
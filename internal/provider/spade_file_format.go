@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strconv"
 	spade "terraform-provider-spade/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -35,6 +34,10 @@ type SpadeFileFormatResourceModel struct {
 	Format types.String `tfsdk:"format"`
 }
 
+// spadeFileFormatFieldPaths maps the fields of file format requests to the
+// attributes they are set from.
+var spadeFileFormatFieldPaths = sameFieldPaths("format")
+
 func (r *SpadeFileFormatResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_file_format"
 }
@@ -90,9 +93,9 @@ func (r *SpadeFileFormatResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	spadeResp, err := r.Client.CreateFileFormat(data.Format.ValueString())
+	spadeResp, err := r.Client.CreateFileFormat(ctx, data.Format.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create file format, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create file format", err, spadeFileFormatFieldPaths)
 		return
 	}
 
@@ -114,9 +117,9 @@ func (r *SpadeFileFormatResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	spadeResp, err := r.Client.ReadFileFormat(data.Id.ValueInt64())
+	spadeResp, err := r.Client.ReadFileFormat(ctx, data.Id.ValueInt64())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read file format, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to read file format", err, nil)
 		return
 	}
 	if spadeResp == nil {
@@ -144,12 +147,13 @@ func (r *SpadeFileFormatResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	spadeResp, err := r.Client.UpdateFileFormat(
+		ctx,
 		data.Id.ValueInt64(),
 		data.Format.ValueString(),
 	)
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update file format, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to update file format", err, spadeFileFormatFieldPaths)
 		return
 	}
 
@@ -171,20 +175,22 @@ func (r *SpadeFileFormatResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
-	err := r.Client.DeleteFileFormat(data.Id.ValueInt64())
+	err := r.Client.DeleteFileFormat(ctx, data.Id.ValueInt64())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete file format, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete file format", err, nil)
 		return
 	}
 }
 
 func (r *SpadeFileFormatResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id, err := strconv.ParseInt(req.ID, 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected a numeric resource ID, got: %s", req.ID),
-		)
+	id, ok := importId(ctx, req.ID, "format", func(ctx context.Context, format string) (int64, error) {
+		fileFormat, err := r.Client.SearchFileFormat(ctx, format)
+		if err != nil {
+			return 0, err
+		}
+		return fileFormat.Id, nil
+	}, &resp.Diagnostics)
+	if !ok {
 		return
 	}
 	resp.Diagnostics.Append(
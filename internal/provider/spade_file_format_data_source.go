@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	spade "terraform-provider-spade/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SpadeFileFormatDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &SpadeFileFormatDataSource{}
+
+func NewSpadeFileFormatDataSource() datasource.DataSource {
+	return &SpadeFileFormatDataSource{}
+}
+
+// SpadeFileFormatDataSource defines the data source implementation.
+type SpadeFileFormatDataSource struct {
+	Client *spade.SpadeClient
+}
+
+// SpadeFileFormatDataSourceModel describes the data source data model.
+type SpadeFileFormatDataSourceModel struct {
+	Id     types.Int64  `tfsdk:"id"`
+	Format types.String `tfsdk:"format"`
+}
+
+func (d *SpadeFileFormatDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_format"
+}
+
+func (d *SpadeFileFormatDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "File format data source, looked up by format or id",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Identifier of the file format",
+				Optional:            true,
+				Computed:            true,
+			},
+			"format": schema.StringAttribute{
+				MarkdownDescription: "File format name",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SpadeFileFormatDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("format"),
+		),
+	}
+}
+
+func (d *SpadeFileFormatDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*spade.SpadeClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spade.SpadeClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.Client = client
+}
+
+func (d *SpadeFileFormatDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SpadeFileFormatDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var spadeResp *spade.SpadeFileFormatReadResponse
+	var err error
+	if !data.Id.IsNull() {
+		spadeResp, err = d.Client.ReadFileFormat(ctx, data.Id.ValueInt64())
+		if err == nil && spadeResp == nil {
+			err = fmt.Errorf("cannot find file format with id: %d", data.Id.ValueInt64())
+		}
+	} else {
+		spadeResp, err = d.Client.SearchFileFormat(ctx, data.Format.ValueString())
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to find file format", err, nil)
+		return
+	}
+
+	// Update the model with the response data
+	data.Id = types.Int64Value(spadeResp.Id)
+	data.Format = types.StringValue(spadeResp.Format)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
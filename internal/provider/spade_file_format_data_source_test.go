@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSpadeFileFormatDataSource(t *testing.T) {
+	format := acctest.RandomWithPrefix("tf_acc_csv")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Exactly one of id and format is required
+			{
+				Config:      `data "spade_file_format" "test" {}`,
+				ExpectError: regexp.MustCompile("Exactly one of these attributes must be configured"),
+			},
+			// Read testing
+			{
+				Config: testAccSpadeFileFormatDataSourceConfig(format),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.spade_file_format.by_format", "id", "spade_file_format.test", "id"),
+					resource.TestCheckResourceAttr("data.spade_file_format.by_format", "format", format),
+					resource.TestCheckResourceAttr("data.spade_file_format.by_id", "format", format),
+				),
+			},
+		},
+	})
+}
+
+func testAccSpadeFileFormatDataSourceConfig(format string) string {
+	return fmt.Sprintf(`
+resource "spade_file_format" "test" {
+  format = %[1]q
+}
+
+data "spade_file_format" "by_format" {
+  format = spade_file_format.test.format
+}
+
+data "spade_file_format" "by_id" {
+  id = spade_file_format.test.id
+}
+`, format)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	spade "terraform-provider-spade/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccSpadeFileFormatResource(t *testing.T) {
+	format := acctest.RandomWithPrefix("tf_acc_csv")
+	var id int64
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSpadeFileFormatResourceConfig(format),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("spade_file_format.test", "format", format),
+					resource.TestCheckResourceAttrSet("spade_file_format.test", "id"),
+					testAccCheckResourceId("spade_file_format.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "spade_file_format.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing by natural key
+			{
+				ResourceName:      "spade_file_format.test",
+				ImportState:       true,
+				ImportStateId:     "format:" + format,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSpadeFileFormatResourceConfig(format + "-v2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_file_format.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("spade_file_format.test", "format", format+"-v2"),
+				),
+			},
+			// Recreate after deletion outside of Terraform
+			{
+				PreConfig: testAccDeleteOutOfBand(t, &id, (*spade.SpadeClient).DeleteFileFormat),
+				Config:    testAccSpadeFileFormatResourceConfig(format + "-v2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_file_format.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSpadeFileFormatResourceConfig(format string) string {
+	return fmt.Sprintf(`
+resource "spade_file_format" "test" {
+  format = %[1]q
+}
+`, format)
+}
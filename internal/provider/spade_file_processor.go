@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strconv"
 	spade "terraform-provider-spade/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -38,6 +37,10 @@ type SpadeFileProcessorResourceModel struct {
 	Callable    types.String `tfsdk:"callable"`
 }
 
+// spadeFileProcessorFieldPaths maps the fields of file processor requests to
+// the attributes they are set from.
+var spadeFileProcessorFieldPaths = sameFieldPaths("name", "description", "callable")
+
 func (r *SpadeFileProcessorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_file_processor"
 }
@@ -104,12 +107,13 @@ func (r *SpadeFileProcessorResource) Create(ctx context.Context, req resource.Cr
 	}
 
 	spadeResp, err := r.Client.CreateFileProcessor(
+		ctx,
 		data.Name.ValueString(),
 		data.Description.ValueString(),
 		data.Callable.ValueString(),
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create file processor, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create file processor", err, spadeFileProcessorFieldPaths)
 		return
 	}
 	// Update the model with the response data
@@ -132,9 +136,9 @@ func (r *SpadeFileProcessorResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
-	spadeResp, err := r.Client.ReadFileProcessor(data.Id.ValueInt64())
+	spadeResp, err := r.Client.ReadFileProcessor(ctx, data.Id.ValueInt64())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read file processor, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to read file processor", err, nil)
 		return
 	}
 	if spadeResp == nil {
@@ -164,6 +168,7 @@ func (r *SpadeFileProcessorResource) Update(ctx context.Context, req resource.Up
 	}
 
 	spadeResp, err := r.Client.UpdateFileProcessor(
+		ctx,
 		data.Id.ValueInt64(),
 		data.Name.ValueString(),
 		data.Description.ValueString(),
@@ -171,7 +176,7 @@ func (r *SpadeFileProcessorResource) Update(ctx context.Context, req resource.Up
 	)
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update file processor, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to update file processor", err, spadeFileProcessorFieldPaths)
 		return
 	}
 
@@ -195,20 +200,22 @@ func (r *SpadeFileProcessorResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
-	err := r.Client.DeleteFileProcessor(data.Id.ValueInt64())
+	err := r.Client.DeleteFileProcessor(ctx, data.Id.ValueInt64())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete file processor, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete file processor", err, nil)
 		return
 	}
 }
 
 func (r *SpadeFileProcessorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id, err := strconv.ParseInt(req.ID, 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected a numeric resource ID, got: %s", req.ID),
-		)
+	id, ok := importId(ctx, req.ID, "name", func(ctx context.Context, name string) (int64, error) {
+		processor, err := r.Client.SearchFileProcessor(ctx, name)
+		if err != nil {
+			return 0, err
+		}
+		return processor.Id, nil
+	}, &resp.Diagnostics)
+	if !ok {
 		return
 	}
 	resp.Diagnostics.Append(
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSpadeFileProcessorDataSource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf_acc_processor")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccSpadeFileProcessorDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.spade_file_processor.by_name", "id", "spade_file_processor.test", "id"),
+					resource.TestCheckResourceAttr("data.spade_file_processor.by_name", "name", name),
+					resource.TestCheckResourceAttr("data.spade_file_processor.by_name", "description", "Loads CSV files"),
+					resource.TestCheckResourceAttr("data.spade_file_processor.by_name", "callable", "processors.CSVProcessor"),
+					resource.TestCheckResourceAttr("data.spade_file_processor.by_id", "name", name),
+				),
+			},
+		},
+	})
+}
+
+func testAccSpadeFileProcessorDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "spade_file_processor" "test" {
+  name        = %[1]q
+  description = "Loads CSV files"
+  callable    = "processors.CSVProcessor"
+}
+
+data "spade_file_processor" "by_name" {
+  name = spade_file_processor.test.name
+}
+
+data "spade_file_processor" "by_id" {
+  id = spade_file_processor.test.id
+}
+`, name)
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	spade "terraform-provider-spade/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccSpadeFileProcessorResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf_acc_processor")
+	var id int64
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSpadeFileProcessorResourceConfig(name, "Loads CSV files"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("spade_file_processor.test", "name", name),
+					resource.TestCheckResourceAttr("spade_file_processor.test", "description", "Loads CSV files"),
+					resource.TestCheckResourceAttr("spade_file_processor.test", "callable", "processors.CSVProcessor"),
+					resource.TestCheckResourceAttrSet("spade_file_processor.test", "id"),
+					testAccCheckResourceId("spade_file_processor.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "spade_file_processor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing by natural key
+			{
+				ResourceName:      "spade_file_processor.test",
+				ImportState:       true,
+				ImportStateId:     "name:" + name,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSpadeFileProcessorResourceConfig(name, "Loads CSV files into the warehouse"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_file_processor.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("spade_file_processor.test", "description", "Loads CSV files into the warehouse"),
+				),
+			},
+			// Recreate after deletion outside of Terraform
+			{
+				PreConfig: testAccDeleteOutOfBand(t, &id, (*spade.SpadeClient).DeleteFileProcessor),
+				Config:    testAccSpadeFileProcessorResourceConfig(name, "Loads CSV files into the warehouse"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_file_processor.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSpadeFileProcessorResourceConfig(name, description string) string {
+	return fmt.Sprintf(`
+resource "spade_file_processor" "test" {
+  name        = %[1]q
+  description = %[2]q
+  callable    = "processors.CSVProcessor"
+}
+`, name, description)
+}
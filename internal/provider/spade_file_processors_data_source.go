@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	spade "terraform-provider-spade/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SpadeFileProcessorsDataSource{}
+
+func NewSpadeFileProcessorsDataSource() datasource.DataSource {
+	return &SpadeFileProcessorsDataSource{}
+}
+
+// SpadeFileProcessorsDataSource defines the data source implementation.
+type SpadeFileProcessorsDataSource struct {
+	Client *spade.SpadeClient
+}
+
+// SpadeFileProcessorsDataSourceModel describes the data source data model.
+type SpadeFileProcessorsDataSourceModel struct {
+	NamePrefix     types.String                        `tfsdk:"name_prefix"`
+	NameRegex      types.String                        `tfsdk:"name_regex"`
+	FileProcessors []SpadeFileProcessorDataSourceModel `tfsdk:"file_processors"`
+}
+
+func (d *SpadeFileProcessorsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_file_processors"
+}
+
+func (d *SpadeFileProcessorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the file processors matching all of the given filters",
+
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only include file processors whose name starts with this prefix",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only include file processors whose name matches this regular expression (RE2 syntax)",
+				Optional:            true,
+			},
+			"file_processors": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching file processors, ordered by identifier",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Identifier of the file processor",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the file processor",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the file processor",
+							Computed:            true,
+						},
+						"callable": schema.StringAttribute{
+							MarkdownDescription: "Python import path to the FileProcessor class",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SpadeFileProcessorsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*spade.SpadeClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spade.SpadeClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.Client = client
+}
+
+func (d *SpadeFileProcessorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SpadeFileProcessorsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameFilter := newNameFilter(data.NamePrefix, data.NameRegex, path.Root("name_regex"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spadeResp, err := d.Client.ListFileProcessors(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to list file processors", err, nil)
+		return
+	}
+
+	sort.Slice(spadeResp, func(i, j int) bool { return spadeResp[i].Id < spadeResp[j].Id })
+
+	// Update the model with the matching file processors
+	data.FileProcessors = []SpadeFileProcessorDataSourceModel{}
+	for _, processor := range spadeResp {
+		if !nameFilter.matches(processor.Name) {
+			continue
+		}
+		data.FileProcessors = append(data.FileProcessors, SpadeFileProcessorDataSourceModel{
+			Id:          types.Int64Value(processor.Id),
+			Name:        types.StringValue(processor.Name),
+			Description: types.StringValue(processor.Description),
+			Callable:    types.StringValue(processor.Callable),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSpadeFileProcessorsDataSource(t *testing.T) {
+	prefix := acctest.RandomWithPrefix("tf_acc_processors")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccSpadeFileProcessorsDataSourceConfig(prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.spade_file_processors.prefix", "file_processors.#", "2"),
+					resource.TestCheckResourceAttrPair("data.spade_file_processors.prefix", "file_processors.0.id", "spade_file_processor.csv", "id"),
+					resource.TestCheckResourceAttr("data.spade_file_processors.prefix", "file_processors.0.callable", "processors.CSVProcessor"),
+					resource.TestCheckResourceAttrPair("data.spade_file_processors.prefix", "file_processors.1.id", "spade_file_processor.json", "id"),
+					resource.TestCheckResourceAttr("data.spade_file_processors.regex", "file_processors.#", "1"),
+					resource.TestCheckResourceAttr("data.spade_file_processors.regex", "file_processors.0.name", prefix+"-json"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSpadeFileProcessorsDataSourceConfig(prefix string) string {
+	return fmt.Sprintf(`
+resource "spade_file_processor" "csv" {
+  name     = "%[1]s-csv"
+  callable = "processors.CSVProcessor"
+}
+
+resource "spade_file_processor" "json" {
+  name     = "%[1]s-json"
+  callable = "processors.JSONProcessor"
+
+  # created second so that it is listed second
+  depends_on = [spade_file_processor.csv]
+}
+
+data "spade_file_processors" "prefix" {
+  name_prefix = "%[1]s-"
+  depends_on  = [spade_file_processor.csv, spade_file_processor.json]
+}
+
+data "spade_file_processors" "regex" {
+  name_regex = "^%[1]s-js"
+  depends_on = [spade_file_processor.csv, spade_file_processor.json]
+}
+`, prefix)
+}
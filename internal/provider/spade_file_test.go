@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	spade "terraform-provider-spade/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccSpadeFileResource(t *testing.T) {
+	code := acctest.RandomWithPrefix("tf_acc_file")
+	var id int64
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSpadeFileResourceConfig(code, "Monthly sales", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("spade_file.test", "code", code),
+					resource.TestCheckResourceAttr("spade_file.test", "description", "Monthly sales"),
+					resource.TestCheckResourceAttr("spade_file.test", "tags.#", "0"),
+					resource.TestCheckResourceAttrPair("spade_file.test", "format", "spade_file_format.test", "id"),
+					resource.TestCheckResourceAttrPair("spade_file.test", "processor", "spade_file_processor.test", "id"),
+					resource.TestCheckResourceAttr("spade_file.test", "user_params", `{"type":"object"}`),
+					resource.TestCheckNoResourceAttr("spade_file.test", "linked_process"),
+					resource.TestCheckResourceAttr("spade_file.test", "variable_sets.#", "1"),
+					testAccCheckResourceId("spade_file.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "spade_file.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing by natural key
+			{
+				ResourceName:      "spade_file.test",
+				ImportState:       true,
+				ImportStateId:     "code:" + code,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSpadeFileResourceConfig(code, "Monthly sales per region", "spade_process.test.id"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_file.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("spade_file.test", "description", "Monthly sales per region"),
+					resource.TestCheckResourceAttrPair("spade_file.test", "linked_process", "spade_process.test", "id"),
+				),
+			},
+			// Recreate after deletion outside of Terraform
+			{
+				PreConfig: testAccDeleteOutOfBand(t, &id, (*spade.SpadeClient).DeleteFile),
+				Config:    testAccSpadeFileResourceConfig(code, "Monthly sales per region", "spade_process.test.id"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_file.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSpadeFileResourceConfig(code, description, linkedProcess string) string {
+	if linkedProcess == "" {
+		linkedProcess = "null"
+	}
+	return fmt.Sprintf(`
+resource "spade_file_format" "test" {
+  format = "%[1]s-csv"
+}
+
+resource "spade_file_processor" "test" {
+  name     = "%[1]s-processor"
+  callable = "processors.CSVProcessor"
+}
+
+resource "spade_executor" "test" {
+  name     = "%[1]s-executor"
+  callable = "executors.Local"
+}
+
+resource "spade_process" "test" {
+  code     = "%[1]s-process"
+  executor = spade_executor.test.id
+}
+
+resource "spade_variable_set" "test" {
+  name = "%[1]s-variables"
+}
+
+resource "spade_file" "test" {
+  code           = %[1]q
+  description    = %[2]q
+  format         = spade_file_format.test.id
+  processor      = spade_file_processor.test.id
+  user_params    = jsonencode({ type = "object" })
+  linked_process = %[3]s
+  variable_sets  = [spade_variable_set.test.id]
+}
+`, code, description, linkedProcess)
+}
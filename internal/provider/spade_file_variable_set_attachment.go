@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	spade "terraform-provider-spade/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func NewSpadeFileVariableSetAttachmentResource() resource.Resource {
+	return &variableSetAttachmentResource{
+		object:   "file",
+		mutexKey: fileMutexKey,
+		readVariableSets: func(ctx context.Context, client *spade.SpadeClient, id int64) ([]int64, bool, error) {
+			file, err := client.ReadFile(ctx, id)
+			if err != nil || file == nil {
+				return nil, false, err
+			}
+			return file.VariableSets, true, nil
+		},
+		updateVariableSets: func(ctx context.Context, client *spade.SpadeClient, id int64, variableSets []int64) error {
+			_, err := client.UpdateFileVariableSets(ctx, id, variableSets)
+			return err
+		},
+	}
+}
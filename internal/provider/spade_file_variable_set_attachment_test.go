@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	spade "terraform-provider-spade/internal/client"
+	"testing"
+)
+
+var testAccFileVariableSetAttachmentObject = testAccVariableSetAttachmentObject{
+	object: "file",
+	config: `
+resource "spade_file_format" "test" {
+  format = "%[1]s-csv"
+}
+
+resource "spade_file_processor" "test" {
+  name     = "%[1]s-processor"
+  callable = "processors.CSVProcessor"
+}
+
+resource "spade_file" "test" {
+  code          = %[1]q
+  format        = spade_file_format.test.id
+  processor     = spade_file_processor.test.id
+  variable_sets = [spade_variable_set.owned.id]
+
+  lifecycle {
+    ignore_changes = [variable_sets]
+  }
+}
+`,
+	readVariableSets: func(ctx context.Context, client *spade.SpadeClient, id int64) ([]int64, error) {
+		file, err := client.ReadFile(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if file == nil {
+			return nil, fmt.Errorf("cannot find file with id: %d", id)
+		}
+		return file.VariableSets, nil
+	},
+	updateVariableSets: func(ctx context.Context, client *spade.SpadeClient, id int64, variableSets []int64) error {
+		_, err := client.UpdateFileVariableSets(ctx, id, variableSets)
+		return err
+	},
+}
+
+func TestAccSpadeFileVariableSetAttachmentResource(t *testing.T) {
+	testAccVariableSetAttachmentResource(t, testAccFileVariableSetAttachmentObject)
+}
+
+func TestAccSpadeFileVariableSetAttachmentResource_invalidImport(t *testing.T) {
+	testAccVariableSetAttachmentResourceInvalidImport(t, "file")
+}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	spade "terraform-provider-spade/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SpadeFilesDataSource{}
+
+func NewSpadeFilesDataSource() datasource.DataSource {
+	return &SpadeFilesDataSource{}
+}
+
+// SpadeFilesDataSource defines the data source implementation.
+type SpadeFilesDataSource struct {
+	Client *spade.SpadeClient
+}
+
+// SpadeFilesDataSourceModel describes the data source data model.
+type SpadeFilesDataSourceModel struct {
+	Tags       types.Set                  `tfsdk:"tags"`
+	CodePrefix types.String               `tfsdk:"code_prefix"`
+	CodeRegex  types.String               `tfsdk:"code_regex"`
+	Files      []SpadeFileDataSourceModel `tfsdk:"files"`
+}
+
+func (d *SpadeFilesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_files"
+}
+
+func (d *SpadeFilesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the files matching all of the given filters",
+
+		Attributes: map[string]schema.Attribute{
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Only include files having all of these tags",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"code_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only include files whose code starts with this prefix",
+				Optional:            true,
+			},
+			"code_regex": schema.StringAttribute{
+				MarkdownDescription: "Only include files whose code matches this regular expression (RE2 syntax)",
+				Optional:            true,
+			},
+			"files": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching files, ordered by identifier",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Identifier of the file",
+							Computed:            true,
+						},
+						"code": schema.StringAttribute{
+							MarkdownDescription: "Name of the file",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the file",
+							Computed:            true,
+						},
+						"tags": schema.SetAttribute{
+							MarkdownDescription: "Tags for the file",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"format": schema.Int64Attribute{
+							MarkdownDescription: "Identifier for file format",
+							Computed:            true,
+						},
+						"processor": schema.Int64Attribute{
+							MarkdownDescription: "Identifier for file processor",
+							Computed:            true,
+						},
+						"system_params": schema.StringAttribute{
+							MarkdownDescription: "JSON of system parameters",
+							CustomType:          jsontypes.NormalizedType{},
+							Computed:            true,
+						},
+						"user_params": schema.StringAttribute{
+							MarkdownDescription: "JSON of user parameters (JsonSchema form)",
+							CustomType:          jsontypes.NormalizedType{},
+							Computed:            true,
+						},
+						"linked_process": schema.Int64Attribute{
+							MarkdownDescription: "Identifier for linked process",
+							Computed:            true,
+						},
+						"variable_sets": schema.SetAttribute{
+							MarkdownDescription: "Variable set identifiers",
+							ElementType:         types.Int64Type,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SpadeFilesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*spade.SpadeClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spade.SpadeClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.Client = client
+}
+
+func (d *SpadeFilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SpadeFilesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	codeFilter := newNameFilter(data.CodePrefix, data.CodeRegex, path.Root("code_regex"), &resp.Diagnostics)
+	tags := tagFilter(ctx, data.Tags, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spadeResp, err := d.Client.ListFiles(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to list files", err, nil)
+		return
+	}
+
+	sort.Slice(spadeResp, func(i, j int) bool { return spadeResp[i].Id < spadeResp[j].Id })
+
+	// Update the model with the matching files
+	data.Files = []SpadeFileDataSourceModel{}
+	for i := range spadeResp {
+		file := &spadeResp[i]
+		if !codeFilter.matches(file.Code) || !hasAllTags(file.Tags, tags) {
+			continue
+		}
+		model, diags := newSpadeFileDataSourceModel(ctx, file)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Files = append(data.Files, model)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSpadeFilesDataSource(t *testing.T) {
+	prefix := acctest.RandomWithPrefix("tf_acc_files")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccSpadeFilesDataSourceConfig(prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.spade_files.all", "files.#", "2"),
+					resource.TestCheckResourceAttr("data.spade_files.finance", "files.#", "1"),
+					resource.TestCheckResourceAttrPair("data.spade_files.finance", "files.0.id", "spade_file.sales", "id"),
+					resource.TestCheckResourceAttrPair("data.spade_files.finance", "files.0.format", "spade_file_format.test", "id"),
+					resource.TestCheckResourceAttrPair("data.spade_files.finance", "files.0.processor", "spade_file_processor.test", "id"),
+					resource.TestCheckNoResourceAttr("data.spade_files.finance", "files.0.linked_process"),
+					resource.TestCheckResourceAttr("data.spade_files.regex", "files.#", "1"),
+					resource.TestCheckResourceAttr("data.spade_files.regex", "files.0.code", prefix+"-stock"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSpadeFilesDataSourceConfig(prefix string) string {
+	return fmt.Sprintf(`
+resource "spade_file_format" "test" {
+  format = "%[1]s-csv"
+}
+
+resource "spade_file_processor" "test" {
+  name     = "%[1]s-processor"
+  callable = "processors.CSVProcessor"
+}
+
+resource "spade_file" "sales" {
+  code      = "%[1]s-sales"
+  tags      = ["finance"]
+  format    = spade_file_format.test.id
+  processor = spade_file_processor.test.id
+}
+
+resource "spade_file" "stock" {
+  code      = "%[1]s-stock"
+  tags      = ["warehouse"]
+  format    = spade_file_format.test.id
+  processor = spade_file_processor.test.id
+}
+
+data "spade_files" "all" {
+  code_prefix = "%[1]s-"
+  depends_on  = [spade_file.sales, spade_file.stock]
+}
+
+data "spade_files" "finance" {
+  tags        = ["finance"]
+  code_prefix = "%[1]s-"
+  depends_on  = [spade_file.sales, spade_file.stock]
+}
+
+data "spade_files" "regex" {
+  code_regex = "^%[1]s-stock$"
+  depends_on = [spade_file.sales, spade_file.stock]
+}
+`, prefix)
+}
@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strconv"
 	spade "terraform-provider-spade/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -35,6 +34,10 @@ type SpadeGroupResourceModel struct {
 	Name types.String `tfsdk:"name"`
 }
 
+// spadeGroupFieldPaths maps the fields of group requests to the attributes they
+// are set from.
+var spadeGroupFieldPaths = sameFieldPaths("name")
+
 func (r *SpadeGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_group"
 }
@@ -90,9 +93,9 @@ func (r *SpadeGroupResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	spadeResp, err := r.Client.CreateGroup(data.Name.ValueString())
+	spadeResp, err := r.Client.CreateGroup(ctx, data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create group, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create group", err, spadeGroupFieldPaths)
 		return
 	}
 
@@ -114,9 +117,9 @@ func (r *SpadeGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	spadeResp, err := r.Client.ReadGroup(data.Id.ValueInt64())
+	spadeResp, err := r.Client.ReadGroup(ctx, data.Id.ValueInt64())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to read group", err, nil)
 		return
 	}
 	if spadeResp == nil {
@@ -144,12 +147,13 @@ func (r *SpadeGroupResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	spadeResp, err := r.Client.UpdateGroup(
+		ctx,
 		data.Id.ValueInt64(),
 		data.Name.ValueString(),
 	)
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update group, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to update group", err, spadeGroupFieldPaths)
 		return
 	}
 
@@ -171,20 +175,22 @@ func (r *SpadeGroupResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	err := r.Client.DeleteGroup(data.Id.ValueInt64())
+	err := r.Client.DeleteGroup(ctx, data.Id.ValueInt64())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete group, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete group", err, nil)
 		return
 	}
 }
 
 func (r *SpadeGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id, err := strconv.ParseInt(req.ID, 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected a numeric resource ID, got: %s", req.ID),
-		)
+	id, ok := importId(ctx, req.ID, "name", func(ctx context.Context, name string) (int64, error) {
+		group, err := r.Client.SearchGroup(ctx, name)
+		if err != nil {
+			return 0, err
+		}
+		return group.Id, nil
+	}, &resp.Diagnostics)
+	if !ok {
 		return
 	}
 	resp.Diagnostics.Append(
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	spade "terraform-provider-spade/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SpadeGroupDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &SpadeGroupDataSource{}
+
+func NewSpadeGroupDataSource() datasource.DataSource {
+	return &SpadeGroupDataSource{}
+}
+
+// SpadeGroupDataSource defines the data source implementation.
+type SpadeGroupDataSource struct {
+	Client *spade.SpadeClient
+}
+
+// SpadeGroupDataSourceModel describes the data source data model.
+type SpadeGroupDataSourceModel struct {
+	Id   types.Int64  `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (d *SpadeGroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (d *SpadeGroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Group data source, looked up by name or id",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Identifier of the group",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Group name",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SpadeGroupDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *SpadeGroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*spade.SpadeClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spade.SpadeClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.Client = client
+}
+
+func (d *SpadeGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SpadeGroupDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var spadeResp *spade.SpadeGroupReadResponse
+	var err error
+	if !data.Id.IsNull() {
+		spadeResp, err = d.Client.ReadGroup(ctx, data.Id.ValueInt64())
+		if err == nil && spadeResp == nil {
+			err = fmt.Errorf("cannot find group with id: %d", data.Id.ValueInt64())
+		}
+	} else {
+		spadeResp, err = d.Client.SearchGroup(ctx, data.Name.ValueString())
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to find group", err, nil)
+		return
+	}
+
+	// Update the model with the response data
+	data.Id = types.Int64Value(spadeResp.Id)
+	data.Name = types.StringValue(spadeResp.Name)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSpadeGroupDataSource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf_acc_group")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Lookup of a missing group
+			{
+				Config:      `data "spade_group" "test" { name = "tf_acc_missing" }`,
+				ExpectError: regexp.MustCompile(`cannot find group with name:\s+tf_acc_missing`),
+			},
+			// Read testing
+			{
+				Config: testAccSpadeGroupDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.spade_group.by_name", "id", "spade_group.test", "id"),
+					resource.TestCheckResourceAttr("data.spade_group.by_name", "name", name),
+					resource.TestCheckResourceAttr("data.spade_group.by_id", "name", name),
+				),
+			},
+		},
+	})
+}
+
+func testAccSpadeGroupDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "spade_group" "test" {
+  name = %[1]q
+}
+
+data "spade_group" "by_name" {
+  name = spade_group.test.name
+}
+
+data "spade_group" "by_id" {
+  id = spade_group.test.id
+}
+`, name)
+}
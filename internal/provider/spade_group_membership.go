@@ -0,0 +1,307 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	spade "terraform-provider-spade/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SpadeGroupMembershipResource{}
+var _ resource.ResourceWithImportState = &SpadeGroupMembershipResource{}
+
+func NewSpadeGroupMembershipResource() resource.Resource {
+	return &SpadeGroupMembershipResource{}
+}
+
+// SpadeGroupMembershipResource defines the resource implementation.
+type SpadeGroupMembershipResource struct {
+	Client *spade.SpadeClient
+}
+
+// SpadeGroupMembershipResourceModel describes the resource data model.
+type SpadeGroupMembershipResourceModel struct {
+	Id    types.Int64 `tfsdk:"id"`
+	Group types.Int64 `tfsdk:"group"`
+	Users types.Set   `tfsdk:"users"`
+}
+
+// spadeGroupMembershipFieldPaths maps the fields of the user requests changing
+// memberships to the attributes they are set from.
+var spadeGroupMembershipFieldPaths = apiFieldPaths{
+	"groups": path.Root("group"),
+}
+
+func (r *SpadeGroupMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_membership"
+}
+
+func (r *SpadeGroupMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Adds users to a group within Spade. Memberships not listed here, " +
+			"including those of other `spade_group_membership` resources, are left untouched",
+
+		Attributes: map[string]schema.Attribute{
+			"group": schema.Int64Attribute{
+				MarkdownDescription: "Identifier of the group",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the users to add to the group",
+				ElementType:         types.Int64Type,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of the group",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SpadeGroupMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*spade.SpadeClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *spade.SpadeClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = client
+}
+
+func (r *SpadeGroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SpadeGroupMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var users []int64
+	resp.Diagnostics.Append(data.Users.ElementsAs(ctx, &users, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, user := range users {
+		err := r.groups(user).set(ctx, data.Group.ValueInt64(), true)
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Unable to add user to group", err, spadeGroupMembershipFieldPaths)
+			return
+		}
+	}
+
+	data.Id = data.Group
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SpadeGroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SpadeGroupMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var users []int64
+	resp.Diagnostics.Append(data.Users.ElementsAs(ctx, &users, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.Client.ReadGroup(ctx, data.Group.ValueInt64())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read group", err, nil)
+		return
+	}
+	if group == nil {
+		// Group no longer exists, remove from Terraform state
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Only keep the users which are still members of the group
+	members := []int64{}
+	for _, user := range users {
+		spadeResp, err := r.Client.ReadUser(ctx, user)
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Unable to read user", err, nil)
+			return
+		}
+		if spadeResp != nil && slices.Contains(spadeResp.Groups, group.Id) {
+			members = append(members, user)
+		}
+	}
+
+	data.Id = types.Int64Value(group.Id)
+	data.Group = types.Int64Value(group.Id)
+	respUsers, diag := basetypes.NewSetValueFrom(ctx, types.Int64Type, members)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Users = respUsers
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SpadeGroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SpadeGroupMembershipResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var users, previousUsers []int64
+	resp.Diagnostics.Append(data.Users.ElementsAs(ctx, &users, false)...)
+	resp.Diagnostics.Append(state.Users.ElementsAs(ctx, &previousUsers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, user := range previousUsers {
+		if slices.Contains(users, user) {
+			continue
+		}
+		err := r.groups(user).set(ctx, data.Group.ValueInt64(), false)
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Unable to remove user from group", err, spadeGroupMembershipFieldPaths)
+			return
+		}
+	}
+	// Users still listed are added again, in case they were removed outside
+	// of Terraform
+	for _, user := range users {
+		err := r.groups(user).set(ctx, data.Group.ValueInt64(), true)
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Unable to add user to group", err, spadeGroupMembershipFieldPaths)
+			return
+		}
+	}
+
+	data.Id = data.Group
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SpadeGroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SpadeGroupMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var users []int64
+	resp.Diagnostics.Append(data.Users.ElementsAs(ctx, &users, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, user := range users {
+		err := r.groups(user).set(ctx, data.Group.ValueInt64(), false)
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Unable to remove user from group", err, spadeGroupMembershipFieldPaths)
+			return
+		}
+	}
+}
+
+func (r *SpadeGroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	groupId, userIds, found := strings.Cut(req.ID, "/")
+	group, err := strconv.ParseInt(groupId, 10, 64)
+	var users []int64
+	if err == nil && found {
+		for _, userId := range strings.Split(userIds, ",") {
+			var user int64
+			user, err = strconv.ParseInt(userId, 10, 64)
+			if err != nil {
+				break
+			}
+			users = append(users, user)
+		}
+	}
+	if err != nil || !found {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected <group id>/<user id>[,<user id>...], got: %s", req.ID),
+		)
+		return
+	}
+
+	respUsers, diag := basetypes.NewSetValueFrom(ctx, types.Int64Type, users)
+	resp.Diagnostics.Append(diag...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), group)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group"), group)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("users"), respUsers)...)
+}
+
+// groups returns the groups of the user, as a list memberships are added to
+// and removed from.
+func (r *SpadeGroupMembershipResource) groups(userId int64) memberList {
+	return memberList{
+		name:     "user",
+		id:       userId,
+		mutexKey: userMutexKey(userId),
+		read: func(ctx context.Context) ([]int64, bool, error) {
+			user, err := r.Client.ReadUser(ctx, userId)
+			if err != nil || user == nil {
+				return nil, false, err
+			}
+			return user.Groups, true, nil
+		},
+		update: func(ctx context.Context, groups []int64) error {
+			_, err := r.Client.UpdateUserGroups(ctx, userId, groups)
+			return err
+		},
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSpadeGroupMembershipResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf_acc_membership")
+	var group, ada, grace, alan int64
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSpadeGroupMembershipResourceConfig(name, `[spade_user.ada.id, spade_user.grace.id]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("spade_group_membership.test", "group", "spade_group.test", "id"),
+					resource.TestCheckResourceAttr("spade_group_membership.test", "users.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("spade_group_membership.test", "users.*", "spade_user.ada", "id"),
+					resource.TestCheckTypeSetElemAttrPair("spade_group_membership.test", "users.*", "spade_user.grace", "id"),
+					testAccCheckResourceId("spade_group.test", &group),
+					testAccCheckResourceId("spade_user.ada", &ada),
+					testAccCheckResourceId("spade_user.grace", &grace),
+					testAccCheckResourceId("spade_user.alan", &alan),
+					testAccCheckGroupMember(t, &ada, &group, true),
+					testAccCheckGroupMember(t, &grace, &group, true),
+					testAccCheckGroupMember(t, &alan, &group, true),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "spade_group_membership.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return fmt.Sprintf("%d/%d,%d", group, ada, grace), nil
+				},
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSpadeGroupMembershipResourceConfig(name, `[spade_user.grace.id]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_group_membership.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("spade_group_membership.test", "users.#", "1"),
+					testAccCheckGroupMember(t, &ada, &group, false),
+					testAccCheckGroupMember(t, &grace, &group, true),
+					testAccCheckGroupMember(t, &alan, &group, true),
+				),
+			},
+			// Membership removed outside of Terraform
+			{
+				PreConfig: func() {
+					if _, err := testAccClient(t).UpdateUserGroups(context.Background(), grace, []int64{}); err != nil {
+						t.Fatalf("unable to update user %d: %s", grace, err)
+					}
+				},
+				Config: testAccSpadeGroupMembershipResourceConfig(name, `[spade_user.grace.id]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_group_membership.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testAccCheckGroupMember(t, &grace, &group, true),
+			},
+			// Delete only removes the memberships of the resource
+			{
+				Config: testAccSpadeGroupMembershipResourceConfig(name, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGroupMember(t, &grace, &group, false),
+					testAccCheckGroupMember(t, &alan, &group, true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSpadeGroupMembershipResource_invalidImport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "spade_group_membership" "test" {
+  group = 12
+  users = [34]
+}
+`,
+				ResourceName:  "spade_group_membership.test",
+				ImportState:   true,
+				ImportStateId: "12",
+				ExpectError:   regexp.MustCompile(`Expected <group id>/<user id>\[,<user id>...\], got:\s+12`),
+			},
+		},
+	})
+}
+
+// testAccCheckGroupMember checks whether the user is a member of the group,
+// as seen by the Spade API.
+func testAccCheckGroupMember(t *testing.T, user, group *int64, member bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		spadeResp, err := testAccClient(t).ReadUser(context.Background(), *user)
+		if err != nil {
+			return err
+		}
+		if spadeResp == nil {
+			return fmt.Errorf("cannot find user with id: %d", *user)
+		}
+		if slices.Contains(spadeResp.Groups, *group) != member {
+			return fmt.Errorf("expected membership of user %d in group %d to be %t, got groups: %v", *user, *group, member, spadeResp.Groups)
+		}
+		return nil
+	}
+}
+
+// testAccSpadeGroupMembershipResourceConfig manages alan's membership through
+// spade_user, while users lists the memberships managed by
+// spade_group_membership. The membership resource is omitted when users is
+// empty.
+func testAccSpadeGroupMembershipResourceConfig(name, users string) string {
+	config := fmt.Sprintf(`
+resource "spade_group" "test" {
+  name = %[1]q
+}
+
+resource "spade_user" "ada" {
+  email = "%[1]s-ada@example.com"
+
+  lifecycle {
+    ignore_changes = [groups]
+  }
+}
+
+resource "spade_user" "grace" {
+  email = "%[1]s-grace@example.com"
+
+  lifecycle {
+    ignore_changes = [groups]
+  }
+}
+
+resource "spade_user" "alan" {
+  email  = "%[1]s-alan@example.com"
+  groups = [spade_group.test.id]
+
+  lifecycle {
+    ignore_changes = [groups]
+  }
+}
+`, name)
+	if users != "" {
+		config += fmt.Sprintf(`
+resource "spade_group_membership" "test" {
+  group = spade_group.test.id
+  users = %s
+}
+`, users)
+	}
+	return config
+}
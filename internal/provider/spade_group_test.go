@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	spade "terraform-provider-spade/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccSpadeGroupResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf_acc_group")
+	var id int64
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSpadeGroupResourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("spade_group.test", "name", name),
+					resource.TestCheckResourceAttrSet("spade_group.test", "id"),
+					testAccCheckResourceId("spade_group.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "spade_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing by natural key
+			{
+				ResourceName:      "spade_group.test",
+				ImportState:       true,
+				ImportStateId:     "name:" + name,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSpadeGroupResourceConfig(name + "-renamed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_group.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("spade_group.test", "name", name+"-renamed"),
+				),
+			},
+			// Recreate after deletion outside of Terraform
+			{
+				PreConfig: testAccDeleteOutOfBand(t, &id, (*spade.SpadeClient).DeleteGroup),
+				Config:    testAccSpadeGroupResourceConfig(name + "-renamed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_group.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSpadeGroupResourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "spade_group" "test" {
+  name = %[1]q
+}
+`, name)
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strconv"
 	spade "terraform-provider-spade/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
@@ -47,6 +46,10 @@ type SpadeProcessResourceModel struct {
 	VariableSets types.Set            `tfsdk:"variable_sets"`
 }
 
+// spadeProcessFieldPaths maps the fields of process requests to the attributes
+// they are set from.
+var spadeProcessFieldPaths = sameFieldPaths("code", "description", "tags", "executor", "system_params", "user_params", "variable_sets")
+
 func (r *SpadeProcessResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_process"
 }
@@ -174,6 +177,7 @@ func (r *SpadeProcessResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	spadeResp, err := r.Client.CreateProcess(
+		ctx,
 		data.Code.ValueString(),
 		data.Description.ValueString(),
 		tagStrings,
@@ -183,7 +187,7 @@ func (r *SpadeProcessResource) Create(ctx context.Context, req resource.CreateRe
 		variableSetIDs,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create process, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create process", err, spadeProcessFieldPaths)
 		return
 	}
 
@@ -233,9 +237,9 @@ func (r *SpadeProcessResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	spadeResp, err := r.Client.ReadProcess(data.Id.ValueInt64())
+	spadeResp, err := r.Client.ReadProcess(ctx, data.Id.ValueInt64())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read process, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to read process", err, nil)
 		return
 	}
 	if spadeResp == nil {
@@ -322,7 +326,10 @@ func (r *SpadeProcessResource) Update(ctx context.Context, req resource.UpdateRe
 		variableSetIDs[i] = id.ValueInt64()
 	}
 
+	spadeMutexKV.Lock(processMutexKey(data.Id.ValueInt64()))
+	defer spadeMutexKV.Unlock(processMutexKey(data.Id.ValueInt64()))
 	spadeResp, err := r.Client.UpdateProcess(
+		ctx,
 		data.Id.ValueInt64(),
 		data.Code.ValueString(),
 		data.Description.ValueString(),
@@ -333,7 +340,7 @@ func (r *SpadeProcessResource) Update(ctx context.Context, req resource.UpdateRe
 		variableSetIDs,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update process, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to update process", err, spadeProcessFieldPaths)
 		return
 	}
 
@@ -383,20 +390,22 @@ func (r *SpadeProcessResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	err := r.Client.DeleteProcess(data.Id.ValueInt64())
+	err := r.Client.DeleteProcess(ctx, data.Id.ValueInt64())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete process, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete process", err, nil)
 		return
 	}
 }
 
 func (r *SpadeProcessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id, err := strconv.ParseInt(req.ID, 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected a numeric resource ID, got: %s", req.ID),
-		)
+	id, ok := importId(ctx, req.ID, "code", func(ctx context.Context, code string) (int64, error) {
+		process, err := r.Client.SearchProcess(ctx, code)
+		if err != nil {
+			return 0, err
+		}
+		return process.Id, nil
+	}, &resp.Diagnostics)
+	if !ok {
 		return
 	}
 	resp.Diagnostics.Append(
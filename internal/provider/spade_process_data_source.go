@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	spade "terraform-provider-spade/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SpadeProcessDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &SpadeProcessDataSource{}
+
+func NewSpadeProcessDataSource() datasource.DataSource {
+	return &SpadeProcessDataSource{}
+}
+
+// SpadeProcessDataSource defines the data source implementation.
+type SpadeProcessDataSource struct {
+	Client *spade.SpadeClient
+}
+
+// SpadeProcessDataSourceModel describes the data source data model.
+type SpadeProcessDataSourceModel struct {
+	Id           types.Int64          `tfsdk:"id"`
+	Code         types.String         `tfsdk:"code"`
+	Description  types.String         `tfsdk:"description"`
+	Tags         types.Set            `tfsdk:"tags"`
+	Executor     types.Int64          `tfsdk:"executor"`
+	SystemParams jsontypes.Normalized `tfsdk:"system_params"`
+	UserParams   jsontypes.Normalized `tfsdk:"user_params"`
+	VariableSets types.Set            `tfsdk:"variable_sets"`
+}
+
+func (d *SpadeProcessDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_process"
+}
+
+func (d *SpadeProcessDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Process data source, looked up by code or id",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Identifier of the process",
+				Optional:            true,
+				Computed:            true,
+			},
+			"code": schema.StringAttribute{
+				MarkdownDescription: "Name of the process",
+				Optional:            true,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the process",
+				Computed:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tags for the process",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"executor": schema.Int64Attribute{
+				MarkdownDescription: "Identifier to the underlying executor",
+				Computed:            true,
+			},
+			"system_params": schema.StringAttribute{
+				MarkdownDescription: "JSON of system parameters",
+				CustomType:          jsontypes.NormalizedType{},
+				Computed:            true,
+			},
+			"user_params": schema.StringAttribute{
+				MarkdownDescription: "JSON of user parameters (JsonSchema form)",
+				CustomType:          jsontypes.NormalizedType{},
+				Computed:            true,
+			},
+			"variable_sets": schema.SetAttribute{
+				MarkdownDescription: "Variable set identifiers",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SpadeProcessDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("code"),
+		),
+	}
+}
+
+func (d *SpadeProcessDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*spade.SpadeClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spade.SpadeClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.Client = client
+}
+
+func (d *SpadeProcessDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SpadeProcessDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var spadeResp *spade.SpadeProcessReadResponse
+	var err error
+	if !data.Id.IsNull() {
+		spadeResp, err = d.Client.ReadProcess(ctx, data.Id.ValueInt64())
+		if err == nil && spadeResp == nil {
+			err = fmt.Errorf("cannot find process with id: %d", data.Id.ValueInt64())
+		}
+	} else {
+		spadeResp, err = d.Client.SearchProcess(ctx, data.Code.ValueString())
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to find process", err, nil)
+		return
+	}
+
+	// Update the model with the response data
+	data, diags := newSpadeProcessDataSourceModel(ctx, spadeResp)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// newSpadeProcessDataSourceModel converts a process returned by the Spade API
+// into the data source model.
+func newSpadeProcessDataSourceModel(ctx context.Context, process *spade.SpadeProcessReadResponse) (SpadeProcessDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	data := SpadeProcessDataSourceModel{
+		Id:          types.Int64Value(process.Id),
+		Code:        types.StringValue(process.Code),
+		Description: types.StringValue(process.Description),
+		Executor:    types.Int64Value(process.Executor),
+	}
+
+	tags, d := basetypes.NewSetValueFrom(ctx, types.StringType, process.Tags)
+	diags.Append(d...)
+	data.Tags = tags
+	variableSets, d := basetypes.NewSetValueFrom(ctx, types.Int64Type, process.VariableSets)
+	diags.Append(d...)
+	data.VariableSets = variableSets
+
+	systemParams, err := json.Marshal(process.SystemParams)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to marshal system_params, got error: %s", err))
+		return data, diags
+	}
+	data.SystemParams = jsontypes.NewNormalizedValue(string(systemParams))
+	userParams, err := json.Marshal(process.UserParams)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to marshal user_params, got error: %s", err))
+		return data, diags
+	}
+	data.UserParams = jsontypes.NewNormalizedValue(string(userParams))
+
+	return data, diags
+}
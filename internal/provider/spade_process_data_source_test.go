@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSpadeProcessDataSource(t *testing.T) {
+	code := acctest.RandomWithPrefix("tf_acc_process")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccSpadeProcessDataSourceConfig(code),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.spade_process.by_code", "id", "spade_process.test", "id"),
+					resource.TestCheckResourceAttr("data.spade_process.by_code", "code", code),
+					resource.TestCheckResourceAttr("data.spade_process.by_code", "description", "Daily sales"),
+					resource.TestCheckResourceAttr("data.spade_process.by_code", "tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.spade_process.by_code", "tags.*", "finance"),
+					resource.TestCheckResourceAttrPair("data.spade_process.by_code", "executor", "spade_executor.test", "id"),
+					resource.TestCheckResourceAttr("data.spade_process.by_code", "system_params", `{"schedule":"daily"}`),
+					resource.TestCheckResourceAttr("data.spade_process.by_code", "user_params", "{}"),
+					resource.TestCheckTypeSetElemAttrPair("data.spade_process.by_code", "variable_sets.*", "spade_variable_set.test", "id"),
+					resource.TestCheckResourceAttr("data.spade_process.by_id", "code", code),
+				),
+			},
+		},
+	})
+}
+
+func testAccSpadeProcessDataSourceConfig(code string) string {
+	return fmt.Sprintf(`
+resource "spade_executor" "test" {
+  name     = "%[1]s-executor"
+  callable = "executors.Local"
+}
+
+resource "spade_variable_set" "test" {
+  name = "%[1]s-variables"
+}
+
+resource "spade_process" "test" {
+  code          = %[1]q
+  description   = "Daily sales"
+  tags          = ["finance"]
+  executor      = spade_executor.test.id
+  system_params = jsonencode({ schedule = "daily" })
+  variable_sets = [spade_variable_set.test.id]
+}
+
+data "spade_process" "by_code" {
+  code = spade_process.test.code
+}
+
+data "spade_process" "by_id" {
+  id = spade_process.test.id
+}
+`, code)
+}
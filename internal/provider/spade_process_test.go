@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	spade "terraform-provider-spade/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccSpadeProcessResource(t *testing.T) {
+	code := acctest.RandomWithPrefix("tf_acc_process")
+	var id int64
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSpadeProcessResourceConfig(code, `["finance"]`, `{ schedule = "daily" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("spade_process.test", "code", code),
+					resource.TestCheckResourceAttr("spade_process.test", "description", ""),
+					resource.TestCheckResourceAttr("spade_process.test", "tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr("spade_process.test", "tags.*", "finance"),
+					resource.TestCheckResourceAttrPair("spade_process.test", "executor", "spade_executor.test", "id"),
+					resource.TestCheckResourceAttr("spade_process.test", "system_params", `{"schedule":"daily"}`),
+					resource.TestCheckResourceAttr("spade_process.test", "user_params", "{}"),
+					resource.TestCheckResourceAttr("spade_process.test", "variable_sets.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("spade_process.test", "variable_sets.*", "spade_variable_set.test", "id"),
+					testAccCheckResourceId("spade_process.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "spade_process.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing by natural key
+			{
+				ResourceName:      "spade_process.test",
+				ImportState:       true,
+				ImportStateId:     "code:" + code,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSpadeProcessResourceConfig(code, `["finance", "daily"]`, `{ schedule = "hourly" }`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_process.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("spade_process.test", "tags.#", "2"),
+					resource.TestCheckResourceAttr("spade_process.test", "system_params", `{"schedule":"hourly"}`),
+				),
+			},
+			// Recreate after deletion outside of Terraform
+			{
+				PreConfig: testAccDeleteOutOfBand(t, &id, (*spade.SpadeClient).DeleteProcess),
+				Config:    testAccSpadeProcessResourceConfig(code, `["finance", "daily"]`, `{ schedule = "hourly" }`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_process.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSpadeProcessResourceConfig(code, tags, systemParams string) string {
+	return fmt.Sprintf(`
+resource "spade_executor" "test" {
+  name     = "%[1]s-executor"
+  callable = "executors.Local"
+}
+
+resource "spade_variable_set" "test" {
+  name = "%[1]s-variables"
+}
+
+resource "spade_process" "test" {
+  code          = %[1]q
+  tags          = %[2]s
+  executor      = spade_executor.test.id
+  system_params = jsonencode(%[3]s)
+  variable_sets = [spade_variable_set.test.id]
+}
+`, code, tags, systemParams)
+}
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	spade "terraform-provider-spade/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func NewSpadeProcessVariableSetAttachmentResource() resource.Resource {
+	return &variableSetAttachmentResource{
+		object:   "process",
+		mutexKey: processMutexKey,
+		readVariableSets: func(ctx context.Context, client *spade.SpadeClient, id int64) ([]int64, bool, error) {
+			process, err := client.ReadProcess(ctx, id)
+			if err != nil || process == nil {
+				return nil, false, err
+			}
+			return process.VariableSets, true, nil
+		},
+		updateVariableSets: func(ctx context.Context, client *spade.SpadeClient, id int64, variableSets []int64) error {
+			_, err := client.UpdateProcessVariableSets(ctx, id, variableSets)
+			return err
+		},
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	spade "terraform-provider-spade/internal/client"
+	"testing"
+)
+
+var testAccProcessVariableSetAttachmentObject = testAccVariableSetAttachmentObject{
+	object: "process",
+	config: `
+resource "spade_executor" "test" {
+  name     = "%[1]s-executor"
+  callable = "executors.Local"
+}
+
+resource "spade_process" "test" {
+  code          = %[1]q
+  executor      = spade_executor.test.id
+  variable_sets = [spade_variable_set.owned.id]
+
+  lifecycle {
+    ignore_changes = [variable_sets]
+  }
+}
+`,
+	readVariableSets: func(ctx context.Context, client *spade.SpadeClient, id int64) ([]int64, error) {
+		process, err := client.ReadProcess(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if process == nil {
+			return nil, fmt.Errorf("cannot find process with id: %d", id)
+		}
+		return process.VariableSets, nil
+	},
+	updateVariableSets: func(ctx context.Context, client *spade.SpadeClient, id int64, variableSets []int64) error {
+		_, err := client.UpdateProcessVariableSets(ctx, id, variableSets)
+		return err
+	},
+}
+
+func TestAccSpadeProcessVariableSetAttachmentResource(t *testing.T) {
+	testAccVariableSetAttachmentResource(t, testAccProcessVariableSetAttachmentObject)
+}
+
+func TestAccSpadeProcessVariableSetAttachmentResource_invalidImport(t *testing.T) {
+	testAccVariableSetAttachmentResourceInvalidImport(t, "process")
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	spade "terraform-provider-spade/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SpadeProcessesDataSource{}
+
+func NewSpadeProcessesDataSource() datasource.DataSource {
+	return &SpadeProcessesDataSource{}
+}
+
+// SpadeProcessesDataSource defines the data source implementation.
+type SpadeProcessesDataSource struct {
+	Client *spade.SpadeClient
+}
+
+// SpadeProcessesDataSourceModel describes the data source data model.
+type SpadeProcessesDataSourceModel struct {
+	Tags       types.Set                     `tfsdk:"tags"`
+	CodePrefix types.String                  `tfsdk:"code_prefix"`
+	CodeRegex  types.String                  `tfsdk:"code_regex"`
+	Processes  []SpadeProcessDataSourceModel `tfsdk:"processes"`
+}
+
+func (d *SpadeProcessesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_processes"
+}
+
+func (d *SpadeProcessesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the processes matching all of the given filters",
+
+		Attributes: map[string]schema.Attribute{
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Only include processes having all of these tags",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"code_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only include processes whose code starts with this prefix",
+				Optional:            true,
+			},
+			"code_regex": schema.StringAttribute{
+				MarkdownDescription: "Only include processes whose code matches this regular expression (RE2 syntax)",
+				Optional:            true,
+			},
+			"processes": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching processes, ordered by identifier",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Identifier of the process",
+							Computed:            true,
+						},
+						"code": schema.StringAttribute{
+							MarkdownDescription: "Name of the process",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the process",
+							Computed:            true,
+						},
+						"tags": schema.SetAttribute{
+							MarkdownDescription: "Tags for the process",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"executor": schema.Int64Attribute{
+							MarkdownDescription: "Identifier to the underlying executor",
+							Computed:            true,
+						},
+						"system_params": schema.StringAttribute{
+							MarkdownDescription: "JSON of system parameters",
+							CustomType:          jsontypes.NormalizedType{},
+							Computed:            true,
+						},
+						"user_params": schema.StringAttribute{
+							MarkdownDescription: "JSON of user parameters (JsonSchema form)",
+							CustomType:          jsontypes.NormalizedType{},
+							Computed:            true,
+						},
+						"variable_sets": schema.SetAttribute{
+							MarkdownDescription: "Variable set identifiers",
+							ElementType:         types.Int64Type,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SpadeProcessesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*spade.SpadeClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spade.SpadeClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.Client = client
+}
+
+func (d *SpadeProcessesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SpadeProcessesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	codeFilter := newNameFilter(data.CodePrefix, data.CodeRegex, path.Root("code_regex"), &resp.Diagnostics)
+	tags := tagFilter(ctx, data.Tags, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spadeResp, err := d.Client.ListProcesses(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to list processes", err, nil)
+		return
+	}
+
+	sort.Slice(spadeResp, func(i, j int) bool { return spadeResp[i].Id < spadeResp[j].Id })
+
+	// Update the model with the matching processes
+	data.Processes = []SpadeProcessDataSourceModel{}
+	for i := range spadeResp {
+		process := &spadeResp[i]
+		if !codeFilter.matches(process.Code) || !hasAllTags(process.Tags, tags) {
+			continue
+		}
+		model, diags := newSpadeProcessDataSourceModel(ctx, process)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Processes = append(data.Processes, model)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSpadeProcessesDataSource(t *testing.T) {
+	prefix := acctest.RandomWithPrefix("tf_acc_processes")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid regular expression
+			{
+				Config:      `data "spade_processes" "test" { code_regex = "(" }`,
+				ExpectError: regexp.MustCompile("Invalid Regular Expression"),
+			},
+			// Read testing
+			{
+				Config: testAccSpadeProcessesDataSourceConfig(prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.spade_processes.all", "processes.#", "3"),
+					resource.TestCheckResourceAttrPair("data.spade_processes.all", "processes.0.id", "spade_process.sales", "id"),
+					resource.TestCheckResourceAttr("data.spade_processes.finance", "processes.#", "2"),
+					resource.TestCheckResourceAttr("data.spade_processes.finance", "processes.0.code", prefix+"-sales"),
+					resource.TestCheckResourceAttr("data.spade_processes.finance", "processes.1.code", prefix+"-costs"),
+					resource.TestCheckResourceAttr("data.spade_processes.daily_finance", "processes.#", "1"),
+					resource.TestCheckResourceAttr("data.spade_processes.daily_finance", "processes.0.code", prefix+"-sales"),
+					resource.TestCheckResourceAttrPair("data.spade_processes.daily_finance", "processes.0.executor", "spade_executor.test", "id"),
+					resource.TestCheckResourceAttr("data.spade_processes.daily_finance", "processes.0.system_params", `{"schedule":"daily"}`),
+					resource.TestCheckResourceAttr("data.spade_processes.regex", "processes.#", "1"),
+					resource.TestCheckResourceAttr("data.spade_processes.regex", "processes.0.code", prefix+"-stock"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSpadeProcessesDataSourceConfig(prefix string) string {
+	return fmt.Sprintf(`
+resource "spade_executor" "test" {
+  name     = "%[1]s-executor"
+  callable = "executors.Local"
+}
+
+resource "spade_process" "sales" {
+  code          = "%[1]s-sales"
+  tags          = ["finance", "daily"]
+  executor      = spade_executor.test.id
+  system_params = jsonencode({ schedule = "daily" })
+}
+
+resource "spade_process" "costs" {
+  code     = "%[1]s-costs"
+  tags     = ["finance"]
+  executor = spade_executor.test.id
+
+  # created in order so that processes are listed in order
+  depends_on = [spade_process.sales]
+}
+
+resource "spade_process" "stock" {
+  code     = "%[1]s-stock"
+  tags     = ["warehouse", "daily"]
+  executor = spade_executor.test.id
+
+  depends_on = [spade_process.costs]
+}
+
+locals {
+  processes = [spade_process.sales.id, spade_process.costs.id, spade_process.stock.id]
+}
+
+data "spade_processes" "all" {
+  code_prefix = "%[1]s-"
+  depends_on  = [local.processes]
+}
+
+data "spade_processes" "finance" {
+  tags        = ["finance"]
+  code_prefix = "%[1]s-"
+  depends_on  = [local.processes]
+}
+
+data "spade_processes" "daily_finance" {
+  tags        = ["finance", "daily"]
+  code_prefix = "%[1]s-"
+  depends_on  = [local.processes]
+}
+
+data "spade_processes" "regex" {
+  code_regex = "^%[1]s-st.ck$"
+  depends_on = [local.processes]
+}
+`, prefix)
+}
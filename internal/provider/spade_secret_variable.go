@@ -6,9 +6,13 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strconv"
 	spade "terraform-provider-spade/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -16,12 +20,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &SpadeSecretVariableResource{}
 var _ resource.ResourceWithImportState = &SpadeSecretVariableResource{}
+var _ resource.ResourceWithConfigValidators = &SpadeSecretVariableResource{}
 
 func NewSpadeSecretVariableResource() resource.Resource {
 	return &SpadeSecretVariableResource{}
@@ -34,11 +41,13 @@ type SpadeSecretVariableResource struct {
 
 // SpadeSecretVariableResourceModel describes the resource data model.
 type SpadeSecretVariableResourceModel struct {
-	Id          types.Int64  `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Value       types.String `tfsdk:"value"`
-	IsSecret    types.Bool   `tfsdk:"is_secret"`
+	Id             types.Int64  `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Value          types.String `tfsdk:"value"`
+	ValueWO        types.String `tfsdk:"value_wo"`
+	ValueWOVersion types.Int64  `tfsdk:"value_wo_version"`
+	IsSecret       types.Bool   `tfsdk:"is_secret"`
 }
 
 func (r *SpadeSecretVariableResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -69,9 +78,25 @@ func (r *SpadeSecretVariableResource) Schema(ctx context.Context, req resource.S
 				Default:             stringdefault.StaticString(""),
 			},
 			"value": schema.StringAttribute{
-				MarkdownDescription: "Value of the secret variable",
-				Required:            true,
+				MarkdownDescription: "Value of the secret variable, stored in the state. Prefer `value_wo` with Terraform 1.11 and later",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.PreferWriteOnlyAttribute(path.MatchRoot("value_wo")),
+				},
+			},
+			"value_wo": schema.StringAttribute{
+				MarkdownDescription: "Value of the secret variable, never stored in the plan or state. Requires Terraform 1.11 or later",
+				Optional:            true,
 				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"value_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `value_wo`. As `value_wo` is not stored, changing it alone is not detected: increment this version to update the value",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("value_wo")),
+				},
 			},
 			"is_secret": schema.BoolAttribute{
 				MarkdownDescription: "Whether the variable is secret (always true)",
@@ -86,6 +111,15 @@ func (r *SpadeSecretVariableResource) Schema(ctx context.Context, req resource.S
 	}
 }
 
+func (r *SpadeSecretVariableResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("value"),
+			path.MatchRoot("value_wo"),
+		),
+	}
+}
+
 func (r *SpadeSecretVariableResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -112,18 +146,23 @@ func (r *SpadeSecretVariableResource) Create(ctx context.Context, req resource.C
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	value := r.configValue(ctx, req.Config, data, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	spadeResp, err := r.Client.CreateVariable(
+		ctx,
 		data.Name.ValueString(),
 		data.Description.ValueString(),
-		data.Value.ValueString(),
+		value,
 		data.IsSecret.ValueBool(),
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create secret variable, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create secret variable", err, data.fieldPaths())
 		return
 	}
 
@@ -148,9 +187,9 @@ func (r *SpadeSecretVariableResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	spadeResp, err := r.Client.ReadVariable(data.Id.ValueInt64())
+	spadeResp, err := r.Client.ReadVariable(ctx, data.Id.ValueInt64())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read secret variable, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to read secret variable", err, nil)
 		return
 	}
 	if spadeResp == nil {
@@ -176,18 +215,24 @@ func (r *SpadeSecretVariableResource) Update(ctx context.Context, req resource.U
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	value := r.configValue(ctx, req.Config, data, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	spadeResp, err := r.Client.UpdateVariable(
+		ctx,
 		data.Id.ValueInt64(),
 		data.Name.ValueString(),
 		data.Description.ValueString(),
-		data.Value.ValueString(),
+		value,
+		data.IsSecret.ValueBool(),
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update secret variable, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to update secret variable", err, data.fieldPaths())
 		return
 	}
 
@@ -212,20 +257,22 @@ func (r *SpadeSecretVariableResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
-	err := r.Client.DeleteVariable(data.Id.ValueInt64())
+	err := r.Client.DeleteVariable(ctx, data.Id.ValueInt64())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete secret variable, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete secret variable", err, nil)
 		return
 	}
 }
 
 func (r *SpadeSecretVariableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id, err := strconv.ParseInt(req.ID, 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected a numeric resource ID, got: %s", req.ID),
-		)
+	id, ok := importId(ctx, req.ID, "name", func(ctx context.Context, name string) (int64, error) {
+		variable, err := r.Client.SearchVariable(ctx, name)
+		if err != nil {
+			return 0, err
+		}
+		return variable.Id, nil
+	}, &resp.Diagnostics)
+	if !ok {
 		return
 	}
 	resp.Diagnostics.Append(
@@ -237,3 +284,26 @@ func (r *SpadeSecretVariableResource) ImportState(ctx context.Context, req resou
 		)...,
 	)
 }
+
+// fieldPaths maps the fields of variable requests to the attributes they are
+// set from, with value mapped to value_wo unless value is set.
+func (m SpadeSecretVariableResourceModel) fieldPaths() apiFieldPaths {
+	paths := sameFieldPaths("name", "description", "is_secret")
+	paths["value"] = path.Root("value_wo")
+	if !m.Value.IsNull() {
+		paths["value"] = path.Root("value")
+	}
+	return paths
+}
+
+// configValue returns the value to send to Spade, taken from value_wo when it
+// is set. Write-only attributes are always null in the plan, so value_wo is
+// read from the configuration.
+func (r *SpadeSecretVariableResource) configValue(ctx context.Context, config tfsdk.Config, data SpadeSecretVariableResourceModel, diags *diag.Diagnostics) string {
+	var valueWO types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("value_wo"), &valueWO)...)
+	if !valueWO.IsNull() {
+		return valueWO.ValueString()
+	}
+	return data.Value.ValueString()
+}
@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	spade "terraform-provider-spade/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccSpadeSecretVariableResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf_acc_secret")
+	var id int64
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSpadeSecretVariableResourceConfig(name, "hunter2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("spade_secret_variable.test", "name", name),
+					resource.TestCheckResourceAttr("spade_secret_variable.test", "description", "Database password"),
+					resource.TestCheckResourceAttr("spade_secret_variable.test", "value", "hunter2"),
+					resource.TestCheckResourceAttr("spade_secret_variable.test", "is_secret", "true"),
+					testAccCheckResourceId("spade_secret_variable.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "spade_secret_variable.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the API does not return secret values
+				ImportStateVerifyIgnore: []string{"value"},
+			},
+			// ImportState testing by natural key
+			{
+				ResourceName:            "spade_secret_variable.test",
+				ImportState:             true,
+				ImportStateId:           "name:" + name,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccSpadeSecretVariableResourceConfig(name, "correct-horse"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_secret_variable.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("spade_secret_variable.test", "value", "correct-horse"),
+				),
+			},
+			// Recreate after deletion outside of Terraform
+			{
+				PreConfig: testAccDeleteOutOfBand(t, &id, (*spade.SpadeClient).DeleteVariable),
+				Config:    testAccSpadeSecretVariableResourceConfig(name, "correct-horse"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_secret_variable.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccSpadeSecretVariableResource_isSecretChanged(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf_acc_secret")
+	var id int64
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckTestServer(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpadeSecretVariableResourceConfig(name, "hunter2"),
+				Check:  testAccCheckResourceId("spade_secret_variable.test", &id),
+			},
+			// is_secret cannot be updated, so the variable is replaced
+			{
+				PreConfig: func() {
+					testAccServer.UpdateObject("variables", id, map[string]interface{}{"is_secret": false})
+				},
+				Config: testAccSpadeSecretVariableResourceConfig(name, "hunter2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_secret_variable.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("spade_secret_variable.test", "is_secret", "true"),
+			},
+		},
+	})
+}
+
+func TestAccSpadeSecretVariableResource_writeOnly(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf_acc_secret")
+	var id int64
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// Write-only attributes are only supported from Terraform 1.11
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		PreCheck: func() {
+			testAccPreCheck(t)
+			// the value is checked on the in-memory API, as Spade hides it
+			testAccPreCheckTestServer(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Only one of value and value_wo can be set
+			{
+				Config: fmt.Sprintf(`
+resource "spade_secret_variable" "test" {
+  name     = %q
+  value    = "hunter2"
+  value_wo = "hunter2"
+}
+`, name),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccSpadeSecretVariableResourceConfigWriteOnly(name, "hunter2", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("spade_secret_variable.test", "value"),
+					resource.TestCheckNoResourceAttr("spade_secret_variable.test", "value_wo"),
+					resource.TestCheckResourceAttr("spade_secret_variable.test", "value_wo_version", "1"),
+					testAccCheckResourceId("spade_secret_variable.test", &id),
+					testAccCheckVariableValue(&id, "hunter2"),
+				),
+			},
+			// Changing value_wo alone is not detected
+			{
+				Config: testAccSpadeSecretVariableResourceConfigWriteOnly(name, "correct-horse", 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: testAccCheckVariableValue(&id, "hunter2"),
+			},
+			// Incrementing value_wo_version updates the value
+			{
+				Config: testAccSpadeSecretVariableResourceConfigWriteOnly(name, "correct-horse", 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_secret_variable.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("spade_secret_variable.test", "value_wo"),
+					testAccCheckVariableValue(&id, "correct-horse"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckVariableValue checks the value of the variable stored by the
+// in-memory Spade API.
+func testAccCheckVariableValue(id *int64, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		object := testAccServer.Object("variables", *id)
+		if object == nil {
+			return fmt.Errorf("cannot find variable with id: %d", *id)
+		}
+		if object["value"] != value {
+			return fmt.Errorf("expected value of variable %d to be %q, got: %v", *id, value, object["value"])
+		}
+		return nil
+	}
+}
+
+func testAccSpadeSecretVariableResourceConfig(name, value string) string {
+	return fmt.Sprintf(`
+resource "spade_secret_variable" "test" {
+  name        = %[1]q
+  description = "Database password"
+  value       = %[2]q
+}
+`, name, value)
+}
+
+func testAccSpadeSecretVariableResourceConfigWriteOnly(name, value string, version int) string {
+	return fmt.Sprintf(`
+resource "spade_secret_variable" "test" {
+  name             = %[1]q
+  description      = "Database password"
+  value_wo         = %[2]q
+  value_wo_version = %[3]d
+}
+`, name, value, version)
+}
@@ -6,10 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strconv"
 	spade "terraform-provider-spade/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -44,6 +44,16 @@ type SpadeUserResourceModel struct {
 	Groups    types.Set    `tfsdk:"groups"`
 }
 
+// spadeUserFieldPaths maps the fields of user requests to the attributes they
+// are set from.
+var spadeUserFieldPaths = apiFieldPaths{
+	"first_name": path.Root("first_name"),
+	"last_name":  path.Root("last_name"),
+	"email":      path.Root("email"),
+	"is_active":  path.Root("active"),
+	"groups":     path.Root("groups"),
+}
+
 func (r *SpadeUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_user"
 }
@@ -135,6 +145,7 @@ func (r *SpadeUserResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	spadeResp, err := r.Client.CreateUser(
+		ctx,
 		data.FirstName.ValueString(),
 		data.LastName.ValueString(),
 		data.Email.ValueString(),
@@ -142,7 +153,7 @@ func (r *SpadeUserResource) Create(ctx context.Context, req resource.CreateReque
 		groupIDs,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create user", err, spadeUserFieldPaths)
 		return
 	}
 
@@ -174,9 +185,9 @@ func (r *SpadeUserResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	spadeResp, err := r.Client.ReadUser(data.Id.ValueInt64())
+	spadeResp, err := r.Client.ReadUser(ctx, data.Id.ValueInt64())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to read user", err, nil)
 		return
 	}
 	if spadeResp == nil {
@@ -223,7 +234,10 @@ func (r *SpadeUserResource) Update(ctx context.Context, req resource.UpdateReque
 		groupIDs[i] = id.ValueInt64()
 	}
 
+	spadeMutexKV.Lock(userMutexKey(data.Id.ValueInt64()))
+	defer spadeMutexKV.Unlock(userMutexKey(data.Id.ValueInt64()))
 	spadeResp, err := r.Client.UpdateUser(
+		ctx,
 		data.Id.ValueInt64(),
 		data.FirstName.ValueString(),
 		data.LastName.ValueString(),
@@ -232,7 +246,7 @@ func (r *SpadeUserResource) Update(ctx context.Context, req resource.UpdateReque
 		groupIDs,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to update user", err, spadeUserFieldPaths)
 		return
 	}
 
@@ -264,20 +278,22 @@ func (r *SpadeUserResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	err := r.Client.DeleteUser(data.Id.ValueInt64())
+	err := r.Client.DeleteUser(ctx, data.Id.ValueInt64())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete user, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete user", err, nil)
 		return
 	}
 }
 
 func (r *SpadeUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id, err := strconv.ParseInt(req.ID, 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected a numeric resource ID, got: %s", req.ID),
-		)
+	id, ok := importId(ctx, req.ID, "email", func(ctx context.Context, email string) (int64, error) {
+		user, err := r.Client.SearchUser(ctx, email)
+		if err != nil {
+			return 0, err
+		}
+		return user.Id, nil
+	}, &resp.Diagnostics)
+	if !ok {
 		return
 	}
 	resp.Diagnostics.Append(
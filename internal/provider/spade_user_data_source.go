@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	spade "terraform-provider-spade/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SpadeUserDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &SpadeUserDataSource{}
+
+func NewSpadeUserDataSource() datasource.DataSource {
+	return &SpadeUserDataSource{}
+}
+
+// SpadeUserDataSource defines the data source implementation.
+type SpadeUserDataSource struct {
+	Client *spade.SpadeClient
+}
+
+// SpadeUserDataSourceModel describes the data source data model.
+type SpadeUserDataSourceModel struct {
+	Id        types.Int64  `tfsdk:"id"`
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+	Email     types.String `tfsdk:"email"`
+	IsActive  types.Bool   `tfsdk:"active"`
+	Groups    types.Set    `tfsdk:"groups"`
+}
+
+func (d *SpadeUserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *SpadeUserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "User data source, looked up by email or id",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Identifier of the user",
+				Optional:            true,
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address",
+				Optional:            true,
+				Computed:            true,
+			},
+			"first_name": schema.StringAttribute{
+				MarkdownDescription: "First name",
+				Computed:            true,
+			},
+			"last_name": schema.StringAttribute{
+				MarkdownDescription: "Last name",
+				Computed:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether or not the account is active",
+				Computed:            true,
+			},
+			"groups": schema.SetAttribute{
+				MarkdownDescription: "Group identifiers",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *SpadeUserDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("email"),
+		),
+	}
+}
+
+func (d *SpadeUserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*spade.SpadeClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spade.SpadeClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.Client = client
+}
+
+func (d *SpadeUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SpadeUserDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var spadeResp *spade.SpadeUserReadResponse
+	var err error
+	if !data.Id.IsNull() {
+		spadeResp, err = d.Client.ReadUser(ctx, data.Id.ValueInt64())
+		if err == nil && spadeResp == nil {
+			err = fmt.Errorf("cannot find user with id: %d", data.Id.ValueInt64())
+		}
+	} else {
+		spadeResp, err = d.Client.SearchUser(ctx, data.Email.ValueString())
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to find user", err, nil)
+		return
+	}
+
+	// Update the model with the response data
+	data, diags := newSpadeUserDataSourceModel(ctx, spadeResp)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// newSpadeUserDataSourceModel converts a user returned by the Spade API into
+// the data source model.
+func newSpadeUserDataSourceModel(ctx context.Context, user *spade.SpadeUserReadResponse) (SpadeUserDataSourceModel, diag.Diagnostics) {
+	groups, diags := basetypes.NewSetValueFrom(ctx, types.Int64Type, user.Groups)
+	return SpadeUserDataSourceModel{
+		Id:        types.Int64Value(user.Id),
+		FirstName: types.StringValue(user.FirstName),
+		LastName:  types.StringValue(user.LastName),
+		Email:     types.StringValue(user.Email),
+		IsActive:  types.BoolValue(user.IsActive),
+		Groups:    groups,
+	}, diags
+}
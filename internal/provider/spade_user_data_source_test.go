@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSpadeUserDataSource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf_acc_user")
+	email := name + "@example.com"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Lookup of a missing user
+			{
+				Config:      `data "spade_user" "test" { email = "tf_acc_missing@example.com" }`,
+				ExpectError: regexp.MustCompile(`cannot find user with email:\s+tf_acc_missing@example.com`),
+			},
+			// Read testing
+			{
+				Config: testAccSpadeUserDataSourceConfig(name, email),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.spade_user.by_email", "id", "spade_user.test", "id"),
+					resource.TestCheckResourceAttr("data.spade_user.by_email", "email", email),
+					resource.TestCheckResourceAttr("data.spade_user.by_email", "first_name", "Ada"),
+					resource.TestCheckResourceAttr("data.spade_user.by_email", "last_name", "Lovelace"),
+					resource.TestCheckResourceAttr("data.spade_user.by_email", "active", "true"),
+					resource.TestCheckResourceAttr("data.spade_user.by_email", "groups.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("data.spade_user.by_email", "groups.*", "spade_group.test", "id"),
+					resource.TestCheckResourceAttr("data.spade_user.by_id", "email", email),
+				),
+			},
+		},
+	})
+}
+
+func testAccSpadeUserDataSourceConfig(name, email string) string {
+	return fmt.Sprintf(`
+resource "spade_group" "test" {
+  name = %[1]q
+}
+
+resource "spade_user" "test" {
+  first_name = "Ada"
+  last_name  = "Lovelace"
+  email      = %[2]q
+  groups     = [spade_group.test.id]
+}
+
+data "spade_user" "by_email" {
+  email = spade_user.test.email
+}
+
+data "spade_user" "by_id" {
+  id = spade_user.test.id
+}
+`, name, email)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	spade "terraform-provider-spade/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccSpadeUserResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf_acc_user")
+	email := name + "@example.com"
+	var id int64
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSpadeUserResourceConfig(name, email, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("spade_user.test", "first_name", "Ada"),
+					resource.TestCheckResourceAttr("spade_user.test", "last_name", ""),
+					resource.TestCheckResourceAttr("spade_user.test", "email", email),
+					resource.TestCheckResourceAttr("spade_user.test", "active", "true"),
+					resource.TestCheckResourceAttr("spade_user.test", "groups.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("spade_user.test", "groups.*", "spade_group.test", "id"),
+					testAccCheckResourceId("spade_user.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "spade_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing by natural key
+			{
+				ResourceName:      "spade_user.test",
+				ImportState:       true,
+				ImportStateId:     "email:" + email,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSpadeUserResourceConfig(name, email, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_user.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("spade_user.test", "active", "false"),
+				),
+			},
+			// Recreate after deletion outside of Terraform
+			{
+				PreConfig: testAccDeleteOutOfBand(t, &id, (*spade.SpadeClient).DeleteUser),
+				Config:    testAccSpadeUserResourceConfig(name, email, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_user.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSpadeUserResourceConfig(name, email string, active bool) string {
+	return fmt.Sprintf(`
+resource "spade_group" "test" {
+  name = %[1]q
+}
+
+resource "spade_user" "test" {
+  first_name = "Ada"
+  email      = %[2]q
+  active     = %[3]t
+  groups     = [spade_group.test.id]
+}
+`, name, email, active)
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	spade "terraform-provider-spade/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SpadeUsersDataSource{}
+
+func NewSpadeUsersDataSource() datasource.DataSource {
+	return &SpadeUsersDataSource{}
+}
+
+// SpadeUsersDataSource defines the data source implementation.
+type SpadeUsersDataSource struct {
+	Client *spade.SpadeClient
+}
+
+// SpadeUsersDataSourceModel describes the data source data model.
+type SpadeUsersDataSourceModel struct {
+	Group    types.Int64                `tfsdk:"group"`
+	IsActive types.Bool                 `tfsdk:"active"`
+	Users    []SpadeUserDataSourceModel `tfsdk:"users"`
+}
+
+func (d *SpadeUsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *SpadeUsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the users matching all of the given filters",
+
+		Attributes: map[string]schema.Attribute{
+			"group": schema.Int64Attribute{
+				MarkdownDescription: "Only include members of the group with this identifier",
+				Optional:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Only include users whose account is (or is not) active",
+				Optional:            true,
+			},
+			"users": schema.ListNestedAttribute{
+				MarkdownDescription: "Matching users, ordered by identifier",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Identifier of the user",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "Email address",
+							Computed:            true,
+						},
+						"first_name": schema.StringAttribute{
+							MarkdownDescription: "First name",
+							Computed:            true,
+						},
+						"last_name": schema.StringAttribute{
+							MarkdownDescription: "Last name",
+							Computed:            true,
+						},
+						"active": schema.BoolAttribute{
+							MarkdownDescription: "Whether or not the account is active",
+							Computed:            true,
+						},
+						"groups": schema.SetAttribute{
+							MarkdownDescription: "Group identifiers",
+							ElementType:         types.Int64Type,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SpadeUsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*spade.SpadeClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *spade.SpadeClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.Client = client
+}
+
+func (d *SpadeUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SpadeUsersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spadeResp, err := d.Client.ListUsers(ctx)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to list users", err, nil)
+		return
+	}
+
+	sort.Slice(spadeResp, func(i, j int) bool { return spadeResp[i].Id < spadeResp[j].Id })
+
+	// Update the model with the matching users
+	data.Users = []SpadeUserDataSourceModel{}
+	for i := range spadeResp {
+		user := &spadeResp[i]
+		if !data.Group.IsNull() && !slices.Contains(user.Groups, data.Group.ValueInt64()) {
+			continue
+		}
+		if !data.IsActive.IsNull() && user.IsActive != data.IsActive.ValueBool() {
+			continue
+		}
+		model, diags := newSpadeUserDataSourceModel(ctx, user)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.Users = append(data.Users, model)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
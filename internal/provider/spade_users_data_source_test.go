@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSpadeUsersDataSource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf_acc_users")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccSpadeUsersDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.spade_users.group", "users.#", "2"),
+					resource.TestCheckResourceAttrPair("data.spade_users.group", "users.0.id", "spade_user.active", "id"),
+					resource.TestCheckResourceAttr("data.spade_users.group", "users.0.email", name+"-active@example.com"),
+					resource.TestCheckResourceAttrPair("data.spade_users.group", "users.1.id", "spade_user.inactive", "id"),
+					resource.TestCheckResourceAttr("data.spade_users.inactive", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.spade_users.inactive", "users.0.email", name+"-inactive@example.com"),
+					resource.TestCheckResourceAttr("data.spade_users.inactive", "users.0.active", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSpadeUsersDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "spade_group" "test" {
+  name = %[1]q
+}
+
+resource "spade_user" "active" {
+  first_name = "Ada"
+  email      = "%[1]s-active@example.com"
+  groups     = [spade_group.test.id]
+}
+
+resource "spade_user" "inactive" {
+  first_name = "Charles"
+  email      = "%[1]s-inactive@example.com"
+  active     = false
+  groups     = [spade_group.test.id]
+
+  # created second so that it is listed second
+  depends_on = [spade_user.active]
+}
+
+data "spade_users" "group" {
+  group      = spade_group.test.id
+  depends_on = [spade_user.active, spade_user.inactive]
+}
+
+data "spade_users" "inactive" {
+  group      = spade_group.test.id
+  active     = false
+  depends_on = [spade_user.active, spade_user.inactive]
+}
+`, name)
+}
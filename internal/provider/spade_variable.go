@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strconv"
 	spade "terraform-provider-spade/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -22,6 +24,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &SpadeVariableResource{}
 var _ resource.ResourceWithImportState = &SpadeVariableResource{}
+var _ resource.ResourceWithConfigValidators = &SpadeVariableResource{}
 
 func NewSpadeVariableResource() resource.Resource {
 	return &SpadeVariableResource{}
@@ -34,11 +37,15 @@ type SpadeVariableResource struct {
 
 // SpadeVariableResourceModel describes the resource data model.
 type SpadeVariableResourceModel struct {
-	Id          types.Int64  `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Value       types.String `tfsdk:"value"`
-	IsSecret    types.Bool   `tfsdk:"is_secret"`
+	Id          types.Int64          `tfsdk:"id"`
+	Name        types.String         `tfsdk:"name"`
+	Description types.String         `tfsdk:"description"`
+	Type        types.String         `tfsdk:"type"`
+	Value       types.String         `tfsdk:"value"`
+	ValueNumber types.Number         `tfsdk:"value_number"`
+	ValueBool   types.Bool           `tfsdk:"value_bool"`
+	ValueJSON   jsontypes.Normalized `tfsdk:"value_json"`
+	IsSecret    types.Bool           `tfsdk:"is_secret"`
 }
 
 func (r *SpadeVariableResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -68,9 +75,29 @@ func (r *SpadeVariableResource) Schema(ctx context.Context, req resource.SchemaR
 				Computed:            true,
 				Default:             stringdefault.StaticString(""),
 			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the value of the variable, set from the value attribute used: `string`, `number`, `bool` or `json`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					variableTypeFromConfig{},
+				},
+			},
 			"value": schema.StringAttribute{
-				MarkdownDescription: "Value of the variable",
-				Required:            true,
+				MarkdownDescription: "Value of the variable, as a string",
+				Optional:            true,
+			},
+			"value_number": schema.NumberAttribute{
+				MarkdownDescription: "Value of the variable, as a number. It is stored in Spade in decimal notation",
+				Optional:            true,
+			},
+			"value_bool": schema.BoolAttribute{
+				MarkdownDescription: "Value of the variable, as a boolean. It is stored in Spade as `true` or `false`",
+				Optional:            true,
+			},
+			"value_json": schema.StringAttribute{
+				MarkdownDescription: "Value of the variable, as JSON. It is stored in Spade compacted, and differences in whitespace or key order are ignored",
+				Optional:            true,
+				CustomType:          jsontypes.NormalizedType{},
 			},
 			"is_secret": schema.BoolAttribute{
 				MarkdownDescription: "Whether the variable is secret (always false)",
@@ -85,6 +112,17 @@ func (r *SpadeVariableResource) Schema(ctx context.Context, req resource.SchemaR
 	}
 }
 
+func (r *SpadeVariableResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("value"),
+			path.MatchRoot("value_number"),
+			path.MatchRoot("value_bool"),
+			path.MatchRoot("value_json"),
+		),
+	}
+}
+
 func (r *SpadeVariableResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -115,14 +153,21 @@ func (r *SpadeVariableResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	value, err := data.apiValue()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to serialise variable value, got error: %s", err))
+		return
+	}
+
 	spadeResp, err := r.Client.CreateVariable(
+		ctx,
 		data.Name.ValueString(),
 		data.Description.ValueString(),
-		data.Value.ValueString(),
+		value,
 		data.IsSecret.ValueBool(),
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create variable, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create variable", err, data.fieldPaths())
 		return
 	}
 
@@ -130,7 +175,7 @@ func (r *SpadeVariableResource) Create(ctx context.Context, req resource.CreateR
 	data.Id = types.Int64Value(spadeResp.Id)
 	data.Name = types.StringValue(spadeResp.Name)
 	data.Description = types.StringValue(spadeResp.Description)
-	data.Value = types.StringValue(spadeResp.Value)
+	data.setValue(spadeResp.Value)
 	data.IsSecret = types.BoolValue(spadeResp.IsSecret)
 
 	// Save data into Terraform state
@@ -147,9 +192,9 @@ func (r *SpadeVariableResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	spadeResp, err := r.Client.ReadVariable(data.Id.ValueInt64())
+	spadeResp, err := r.Client.ReadVariable(ctx, data.Id.ValueInt64())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read variable, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to read variable", err, nil)
 		return
 	}
 	if spadeResp == nil {
@@ -162,7 +207,7 @@ func (r *SpadeVariableResource) Read(ctx context.Context, req resource.ReadReque
 	data.Id = types.Int64Value(spadeResp.Id)
 	data.Name = types.StringValue(spadeResp.Name)
 	data.Description = types.StringValue(spadeResp.Description)
-	data.Value = types.StringValue(spadeResp.Value)
+	data.setValue(spadeResp.Value)
 	data.IsSecret = types.BoolValue(spadeResp.IsSecret)
 
 	// Save updated data into Terraform state
@@ -179,14 +224,22 @@ func (r *SpadeVariableResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	value, err := data.apiValue()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to serialise variable value, got error: %s", err))
+		return
+	}
+
 	spadeResp, err := r.Client.UpdateVariable(
+		ctx,
 		data.Id.ValueInt64(),
 		data.Name.ValueString(),
 		data.Description.ValueString(),
-		data.Value.ValueString(),
+		value,
+		data.IsSecret.ValueBool(),
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update variable, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to update variable", err, data.fieldPaths())
 		return
 	}
 
@@ -194,7 +247,7 @@ func (r *SpadeVariableResource) Update(ctx context.Context, req resource.UpdateR
 	data.Id = types.Int64Value(spadeResp.Id)
 	data.Name = types.StringValue(spadeResp.Name)
 	data.Description = types.StringValue(spadeResp.Description)
-	data.Value = types.StringValue(spadeResp.Value)
+	data.setValue(spadeResp.Value)
 	data.IsSecret = types.BoolValue(spadeResp.IsSecret)
 
 	// Save updated data into Terraform state
@@ -211,20 +264,22 @@ func (r *SpadeVariableResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	err := r.Client.DeleteVariable(data.Id.ValueInt64())
+	err := r.Client.DeleteVariable(ctx, data.Id.ValueInt64())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete variable, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete variable", err, nil)
 		return
 	}
 }
 
 func (r *SpadeVariableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id, err := strconv.ParseInt(req.ID, 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected a numeric resource ID, got: %s", req.ID),
-		)
+	id, ok := importId(ctx, req.ID, "name", func(ctx context.Context, name string) (int64, error) {
+		variable, err := r.Client.SearchVariable(ctx, name)
+		if err != nil {
+			return 0, err
+		}
+		return variable.Id, nil
+	}, &resp.Diagnostics)
+	if !ok {
 		return
 	}
 	resp.Diagnostics.Append(
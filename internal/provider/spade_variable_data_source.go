@@ -98,9 +98,9 @@ func (d *SpadeVariableDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	spadeResp, err := d.Client.SearchVariable(data.Name.ValueString())
+	spadeResp, err := d.Client.SearchVariable(ctx, data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find variable, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to find variable", err, nil)
 		return
 	}
 
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSpadeVariableDataSource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf_acc_variable")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccSpadeVariableDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.spade_variable.test", "id", "spade_variable.test", "id"),
+					resource.TestCheckResourceAttr("data.spade_variable.test", "name", name),
+					resource.TestCheckResourceAttr("data.spade_variable.test", "description", "AWS region"),
+					resource.TestCheckResourceAttr("data.spade_variable.test", "value", "eu-west-1"),
+					resource.TestCheckResourceAttr("data.spade_variable.test", "is_secret", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSpadeVariableDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "spade_variable" "test" {
+  name        = %[1]q
+  description = "AWS region"
+  value       = "eu-west-1"
+}
+
+data "spade_variable" "test" {
+  name = spade_variable.test.name
+}
+`, name)
+}
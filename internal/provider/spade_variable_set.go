@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strconv"
 	spade "terraform-provider-spade/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -41,6 +40,10 @@ type SpadeVariableSetResourceModel struct {
 	Variables   types.Set    `tfsdk:"variables"`
 }
 
+// spadeVariableSetFieldPaths maps the fields of variable set requests to the
+// attributes they are set from.
+var spadeVariableSetFieldPaths = sameFieldPaths("name", "description", "variables")
+
 func (r *SpadeVariableSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_variable_set"
 }
@@ -121,12 +124,13 @@ func (r *SpadeVariableSetResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	spadeResp, err := r.Client.CreateVariableSet(
+		ctx,
 		data.Name.ValueString(),
 		data.Description.ValueString(),
 		variableIDs,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create variable set, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to create variable set", err, spadeVariableSetFieldPaths)
 		return
 	}
 
@@ -156,9 +160,9 @@ func (r *SpadeVariableSetResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	spadeResp, err := r.Client.ReadVariableSet(data.Id.ValueInt64())
+	spadeResp, err := r.Client.ReadVariableSet(ctx, data.Id.ValueInt64())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read variable set, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to read variable set", err, nil)
 		return
 	}
 	if spadeResp == nil {
@@ -204,14 +208,17 @@ func (r *SpadeVariableSetResource) Update(ctx context.Context, req resource.Upda
 		variableIDs[i] = id.ValueInt64()
 	}
 
+	spadeMutexKV.Lock(variableSetMutexKey(data.Id.ValueInt64()))
+	defer spadeMutexKV.Unlock(variableSetMutexKey(data.Id.ValueInt64()))
 	spadeResp, err := r.Client.UpdateVariableSet(
+		ctx,
 		data.Id.ValueInt64(),
 		data.Name.ValueString(),
 		data.Description.ValueString(),
 		variableIDs,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update variable set, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to update variable set", err, spadeVariableSetFieldPaths)
 		return
 	}
 
@@ -241,20 +248,22 @@ func (r *SpadeVariableSetResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	err := r.Client.DeleteVariableSet(data.Id.ValueInt64())
+	err := r.Client.DeleteVariableSet(ctx, data.Id.ValueInt64())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete variable set, got error: %s", err))
+		addClientError(&resp.Diagnostics, "Unable to delete variable set", err, nil)
 		return
 	}
 }
 
 func (r *SpadeVariableSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id, err := strconv.ParseInt(req.ID, 10, 64)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unexpected Import Identifier",
-			fmt.Sprintf("Expected a numeric resource ID, got: %s", req.ID),
-		)
+	id, ok := importId(ctx, req.ID, "name", func(ctx context.Context, name string) (int64, error) {
+		variableSet, err := r.Client.SearchVariableSet(ctx, name)
+		if err != nil {
+			return 0, err
+		}
+		return variableSet.Id, nil
+	}, &resp.Diagnostics)
+	if !ok {
 		return
 	}
 	resp.Diagnostics.Append(
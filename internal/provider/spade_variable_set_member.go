@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	spade "terraform-provider-spade/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SpadeVariableSetMemberResource{}
+var _ resource.ResourceWithImportState = &SpadeVariableSetMemberResource{}
+
+func NewSpadeVariableSetMemberResource() resource.Resource {
+	return &SpadeVariableSetMemberResource{}
+}
+
+// SpadeVariableSetMemberResource defines the resource implementation.
+type SpadeVariableSetMemberResource struct {
+	Client *spade.SpadeClient
+}
+
+// SpadeVariableSetMemberResourceModel describes the resource data model.
+type SpadeVariableSetMemberResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	VariableSet types.Int64  `tfsdk:"variable_set"`
+	Variable    types.Int64  `tfsdk:"variable"`
+}
+
+// spadeVariableSetMemberFieldPaths maps the fields of the variable set requests
+// changing members to the attributes they are set from.
+var spadeVariableSetMemberFieldPaths = apiFieldPaths{
+	"variables": path.Root("variable"),
+}
+
+func (r *SpadeVariableSetMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variable_set_member"
+}
+
+func (r *SpadeVariableSetMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Adds a variable to a variable set within Spade. The other variables " +
+			"of the set are left untouched",
+
+		Attributes: map[string]schema.Attribute{
+			"variable_set": schema.Int64Attribute{
+				MarkdownDescription: "Identifier of the variable set",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"variable": schema.Int64Attribute{
+				MarkdownDescription: "Identifier of the variable",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifiers of the variable set and of the variable, separated by a slash",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SpadeVariableSetMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*spade.SpadeClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *spade.SpadeClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = client
+}
+
+func (r *SpadeVariableSetMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SpadeVariableSetMemberResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.variables(data.VariableSet.ValueInt64()).set(ctx, data.Variable.ValueInt64(), true)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to add variable to variable set", err, spadeVariableSetMemberFieldPaths)
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%d/%d", data.VariableSet.ValueInt64(), data.Variable.ValueInt64()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SpadeVariableSetMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SpadeVariableSetMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spadeResp, err := r.Client.ReadVariableSet(ctx, data.VariableSet.ValueInt64())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read variable set", err, nil)
+		return
+	}
+	if spadeResp == nil || !slices.Contains(spadeResp.Variables, data.Variable.ValueInt64()) {
+		// Variable set or membership no longer exists, remove from Terraform state
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%d/%d", data.VariableSet.ValueInt64(), data.Variable.ValueInt64()))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SpadeVariableSetMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require replacement, so there is nothing to update
+	var data SpadeVariableSetMemberResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SpadeVariableSetMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SpadeVariableSetMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.variables(data.VariableSet.ValueInt64()).set(ctx, data.Variable.ValueInt64(), false)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to remove variable from variable set", err, spadeVariableSetMemberFieldPaths)
+		return
+	}
+}
+
+func (r *SpadeVariableSetMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	variableSetId, variableId, found := strings.Cut(req.ID, "/")
+	variableSet, err := strconv.ParseInt(variableSetId, 10, 64)
+	var variable int64
+	if err == nil {
+		variable, err = strconv.ParseInt(variableId, 10, 64)
+	}
+	if err != nil || !found {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected <variable set id>/<variable id>, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("variable_set"), variableSet)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("variable"), variable)...)
+}
+
+// variables returns the variables of the variable set, as a list members are
+// added to and removed from.
+func (r *SpadeVariableSetMemberResource) variables(variableSetId int64) memberList {
+	return memberList{
+		name:     "variable set",
+		id:       variableSetId,
+		mutexKey: variableSetMutexKey(variableSetId),
+		read: func(ctx context.Context) ([]int64, bool, error) {
+			variableSet, err := r.Client.ReadVariableSet(ctx, variableSetId)
+			if err != nil || variableSet == nil {
+				return nil, false, err
+			}
+			return variableSet.Variables, true, nil
+		},
+		update: func(ctx context.Context, variables []int64) error {
+			_, err := r.Client.UpdateVariableSetVariables(ctx, variableSetId, variables)
+			return err
+		},
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSpadeVariableSetMemberResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf_acc_member")
+	var variableSet, region, password, token int64
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSpadeVariableSetMemberResourceConfig(name, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("spade_variable_set_member.password", "variable_set", "spade_variable_set.test", "id"),
+					resource.TestCheckResourceAttrPair("spade_variable_set_member.password", "variable", "spade_secret_variable.password", "id"),
+					testAccCheckResourceId("spade_variable_set.test", &variableSet),
+					testAccCheckResourceId("spade_variable.region", &region),
+					testAccCheckResourceId("spade_secret_variable.password", &password),
+					testAccCheckResourceId("spade_secret_variable.token", &token),
+					testAccCheckVariableSetMember(t, &variableSet, &region, true),
+					testAccCheckVariableSetMember(t, &variableSet, &password, true),
+					testAccCheckVariableSetMember(t, &variableSet, &token, true),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "spade_variable_set_member.password",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return fmt.Sprintf("%d/%d", variableSet, password), nil
+				},
+				ImportStateVerify: true,
+			},
+			// Membership removed outside of Terraform
+			{
+				PreConfig: func() {
+					if _, err := testAccClient(t).UpdateVariableSetVariables(context.Background(), variableSet, []int64{region, token}); err != nil {
+						t.Fatalf("unable to update variable set %d: %s", variableSet, err)
+					}
+				},
+				Config: testAccSpadeVariableSetMemberResourceConfig(name, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_variable_set_member.password", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: testAccCheckVariableSetMember(t, &variableSet, &password, true),
+			},
+			// Delete only removes the variable of the resource
+			{
+				Config: testAccSpadeVariableSetMemberResourceConfig(name, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVariableSetMember(t, &variableSet, &region, true),
+					testAccCheckVariableSetMember(t, &variableSet, &password, false),
+					testAccCheckVariableSetMember(t, &variableSet, &token, true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSpadeVariableSetMemberResource_missingVariable(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf_acc_member")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The error on the variables of the set is reported against
+			// the variable attribute
+			{
+				Config: fmt.Sprintf(`
+resource "spade_variable_set" "test" {
+  name = %q
+}
+
+resource "spade_variable_set_member" "test" {
+  variable_set = spade_variable_set.test.id
+  variable     = 999999999
+}
+`, name),
+				ExpectError: regexp.MustCompile(`Spade rejected variable with status\s+code 400`),
+			},
+		},
+	})
+}
+
+func TestAccSpadeVariableSetMemberResource_invalidImport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "spade_variable_set_member" "test" {
+  variable_set = 12
+  variable     = 34
+}
+`,
+				ResourceName:  "spade_variable_set_member.test",
+				ImportState:   true,
+				ImportStateId: "12",
+				ExpectError:   regexp.MustCompile(`Expected <variable set id>/<variable id>, got:\s+12`),
+			},
+		},
+	})
+}
+
+// testAccCheckVariableSetMember checks whether the variable belongs to the
+// variable set, as seen by the Spade API.
+func testAccCheckVariableSetMember(t *testing.T, variableSet, variable *int64, member bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		spadeResp, err := testAccClient(t).ReadVariableSet(context.Background(), *variableSet)
+		if err != nil {
+			return err
+		}
+		if spadeResp == nil {
+			return fmt.Errorf("cannot find variable set with id: %d", *variableSet)
+		}
+		if slices.Contains(spadeResp.Variables, *variable) != member {
+			return fmt.Errorf("expected membership of variable %d in variable set %d to be %t, got variables: %v", *variable, *variableSet, member, spadeResp.Variables)
+		}
+		return nil
+	}
+}
+
+// testAccSpadeVariableSetMemberResourceConfig lists the region variable in
+// the variable set itself, while the two secrets are added by
+// spade_variable_set_member resources. The password member is omitted unless
+// withPassword is set.
+func testAccSpadeVariableSetMemberResourceConfig(name string, withPassword bool) string {
+	config := fmt.Sprintf(`
+resource "spade_variable" "region" {
+  name  = "%[1]s_region"
+  value = "eu-west-1"
+}
+
+resource "spade_secret_variable" "password" {
+  name  = "%[1]s_password"
+  value = "hunter2"
+}
+
+resource "spade_secret_variable" "token" {
+  name  = "%[1]s_token"
+  value = "s3cr3t"
+}
+
+resource "spade_variable_set" "test" {
+  name      = %[1]q
+  variables = [spade_variable.region.id]
+
+  lifecycle {
+    ignore_changes = [variables]
+  }
+}
+
+resource "spade_variable_set_member" "token" {
+  variable_set = spade_variable_set.test.id
+  variable     = spade_secret_variable.token.id
+}
+`, name)
+	if withPassword {
+		config += `
+resource "spade_variable_set_member" "password" {
+  variable_set = spade_variable_set.test.id
+  variable     = spade_secret_variable.password.id
+}
+`
+	}
+	return config
+}
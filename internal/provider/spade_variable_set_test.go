@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	spade "terraform-provider-spade/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccSpadeVariableSetResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf_acc_variable_set")
+	var id int64
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSpadeVariableSetResourceConfig(name, "[spade_variable.region.id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("spade_variable_set.test", "name", name),
+					resource.TestCheckResourceAttr("spade_variable_set.test", "description", ""),
+					resource.TestCheckResourceAttr("spade_variable_set.test", "variables.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("spade_variable_set.test", "variables.*", "spade_variable.region", "id"),
+					testAccCheckResourceId("spade_variable_set.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "spade_variable_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing by natural key
+			{
+				ResourceName:      "spade_variable_set.test",
+				ImportState:       true,
+				ImportStateId:     "name:" + name,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSpadeVariableSetResourceConfig(name, "[spade_variable.region.id, spade_secret_variable.password.id]"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_variable_set.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("spade_variable_set.test", "variables.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("spade_variable_set.test", "variables.*", "spade_secret_variable.password", "id"),
+				),
+			},
+			// Recreate after deletion outside of Terraform
+			{
+				PreConfig: testAccDeleteOutOfBand(t, &id, (*spade.SpadeClient).DeleteVariableSet),
+				Config:    testAccSpadeVariableSetResourceConfig(name, "[spade_variable.region.id, spade_secret_variable.password.id]"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_variable_set.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSpadeVariableSetResourceConfig(name, variables string) string {
+	return fmt.Sprintf(`
+resource "spade_variable" "region" {
+  name  = "%[1]s_region"
+  value = "eu-west-1"
+}
+
+resource "spade_secret_variable" "password" {
+  name  = "%[1]s_password"
+  value = "hunter2"
+}
+
+resource "spade_variable_set" "test" {
+  name      = %[1]q
+  variables = %[2]s
+}
+`, name, variables)
+}
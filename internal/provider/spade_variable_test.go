@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	spade "terraform-provider-spade/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccSpadeVariableResource(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf_acc_variable")
+	var id int64
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSpadeVariableResourceConfig(name, "eu-west-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("spade_variable.test", "name", name),
+					resource.TestCheckResourceAttr("spade_variable.test", "description", "AWS region"),
+					resource.TestCheckResourceAttr("spade_variable.test", "value", "eu-west-1"),
+					resource.TestCheckResourceAttr("spade_variable.test", "is_secret", "false"),
+					testAccCheckResourceId("spade_variable.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "spade_variable.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState testing by natural key
+			{
+				ResourceName:      "spade_variable.test",
+				ImportState:       true,
+				ImportStateId:     "name:" + name,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSpadeVariableResourceConfig(name, "eu-central-1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_variable.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("spade_variable.test", "value", "eu-central-1"),
+				),
+			},
+			// Recreate after deletion outside of Terraform
+			{
+				PreConfig: testAccDeleteOutOfBand(t, &id, (*spade.SpadeClient).DeleteVariable),
+				Config:    testAccSpadeVariableResourceConfig(name, "eu-central-1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_variable.test", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccSpadeVariableResource_isSecretChanged(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf_acc_variable")
+	var id int64
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckTestServer(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpadeVariableResourceConfig(name, "eu-west-1"),
+				Check:  testAccCheckResourceId("spade_variable.test", &id),
+			},
+			// is_secret cannot be updated, so the variable is replaced
+			{
+				PreConfig: func() {
+					testAccServer.UpdateObject("variables", id, map[string]interface{}{"is_secret": true})
+				},
+				Config: testAccSpadeVariableResourceConfig(name, "eu-west-1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_variable.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("spade_variable.test", "is_secret", "false"),
+			},
+		},
+	})
+}
+
+func TestAccSpadeVariableResource_typed(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf_acc_variable")
+	var id int64
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			// values are changed on the in-memory API to check how they are read
+			testAccPreCheckTestServer(t)
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Only one value attribute can be set
+			{
+				Config: fmt.Sprintf(`
+resource "spade_variable" "test" {
+  name       = %q
+  value      = "1"
+  value_bool = true
+}
+`, name),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// JSON values are validated at plan time
+			{
+				Config:      testAccSpadeVariableResourceConfigTyped(name, "value_json", `"{"`),
+				ExpectError: regexp.MustCompile(`Invalid JSON String Value`),
+			},
+			// JSON values are stored compacted
+			{
+				Config: testAccSpadeVariableResourceConfigTyped(name, "value_json", `<<-EOT
+    {"tables": ["orders", "customers"], "limit": 10}
+  EOT`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("spade_variable.test", "type", "json"),
+					resource.TestCheckNoResourceAttr("spade_variable.test", "value"),
+					testAccCheckResourceId("spade_variable.test", &id),
+					testAccCheckVariableValue(&id, `{"tables":["orders","customers"],"limit":10}`),
+				),
+			},
+			// Reformatting the JSON outside of Terraform is not a change
+			{
+				PreConfig: func() {
+					testAccServer.UpdateObject("variables", id, map[string]interface{}{"value": `{"limit": 10, "tables": ["orders", "customers"]}`})
+				},
+				Config: testAccSpadeVariableResourceConfigTyped(name, "value_json", `<<-EOT
+    {"tables": ["orders", "customers"], "limit": 10}
+  EOT`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Numbers are stored in decimal notation
+			{
+				Config: testAccSpadeVariableResourceConfigTyped(name, "value_number", "1e-1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_variable.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("spade_variable.test", "type", "number"),
+					resource.TestCheckResourceAttr("spade_variable.test", "value_number", "0.1"),
+					resource.TestCheckNoResourceAttr("spade_variable.test", "value_json"),
+					testAccCheckVariableValue(&id, "0.1"),
+				),
+			},
+			{
+				Config: testAccSpadeVariableResourceConfigTyped(name, "value_bool", "true"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("spade_variable.test", "type", "bool"),
+					resource.TestCheckResourceAttr("spade_variable.test", "value_bool", "true"),
+					testAccCheckVariableValue(&id, "true"),
+				),
+			},
+			// A value which is no longer of the right type is set back
+			{
+				PreConfig: func() {
+					testAccServer.UpdateObject("variables", id, map[string]interface{}{"value": "yes"})
+				},
+				Config: testAccSpadeVariableResourceConfigTyped(name, "value_bool", "true"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_variable.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testAccCheckVariableValue(&id, "true"),
+			},
+			// Switching back to a string value
+			{
+				Config: testAccSpadeVariableResourceConfig(name, "true"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("spade_variable.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("spade_variable.test", "type", "string"),
+					resource.TestCheckResourceAttr("spade_variable.test", "value", "true"),
+					resource.TestCheckNoResourceAttr("spade_variable.test", "value_bool"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSpadeVariableResourceConfig(name, value string) string {
+	return fmt.Sprintf(`
+resource "spade_variable" "test" {
+  name        = %[1]q
+  description = "AWS region"
+  value       = %[2]q
+}
+`, name, value)
+}
+
+func testAccSpadeVariableResourceConfigTyped(name, attribute, value string) string {
+	return fmt.Sprintf(`
+resource "spade_variable" "test" {
+  name        = %[1]q
+  description = "Export settings"
+  %[2]s = %[3]s
+}
+`, name, attribute, value)
+}
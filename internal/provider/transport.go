@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// newHTTPClient builds the HTTP client used to reach Spade, applying the TLS,
+// proxy and timeout settings from the provider configuration to a dedicated
+// transport.
+func newHTTPClient(data SpadeProviderModel, diags *diag.Diagnostics) *http.Client {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// explicitly opted into by the user, e.g. for test instances
+		InsecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+	}
+
+	caCert := []byte(data.CACertPEM.ValueString())
+	if !data.CACertFile.IsNull() {
+		var err error
+		caCert, err = os.ReadFile(data.CACertFile.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("ca_cert_file"), "CA Certificate Error", fmt.Sprintf("Unable to read CA certificate file: %s", err))
+			return nil
+		}
+	}
+	if len(caCert) > 0 {
+		// trust the given CA in addition to the system roots, without
+		// modifying the system trust store
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caCert) {
+			attribute := "ca_cert_pem"
+			if !data.CACertFile.IsNull() {
+				attribute = "ca_cert_file"
+			}
+			diags.AddAttributeError(path.Root(attribute), "CA Certificate Error", "No PEM-encoded certificates found")
+			return nil
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if !data.ClientCert.IsNull() {
+		cert, err := tls.X509KeyPair([]byte(data.ClientCert.ValueString()), []byte(data.ClientKey.ValueString()))
+		if err != nil {
+			diags.AddAttributeError(path.Root("client_cert"), "Client Certificate Error", fmt.Sprintf("Unable to load client certificate: %s", err))
+			return nil
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	var transport *http.Transport
+	if defaultTransport, ok := http.DefaultTransport.(*http.Transport); ok {
+		transport = defaultTransport.Clone()
+	} else {
+		transport = &http.Transport{Proxy: http.ProxyFromEnvironment}
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	if !data.ProxyURL.IsNull() {
+		proxyURL, err := url.Parse(data.ProxyURL.ValueString())
+		if err != nil || proxyURL.Host == "" {
+			diags.AddAttributeError(path.Root("proxy_url"), "Invalid Proxy URL", fmt.Sprintf("Unable to parse proxy URL %q", data.ProxyURL.ValueString()))
+			return nil
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	timeout := parseDuration(data.RequestTimeout, path.Root("request_timeout"), 0, diags)
+	if diags.HasError() {
+		return nil
+	}
+	return &http.Client{
+		Transport: transport,
+		Timeout:   timeout,
+	}
+}
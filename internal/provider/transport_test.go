@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testCertificate is a certificate and its key, PEM encoded.
+type testCertificate struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM string
+	keyPEM  string
+}
+
+// newTestCertificate issues a certificate from template, signed by parent or
+// self-signed if parent is nil.
+func newTestCertificate(t *testing.T, template *x509.Certificate, parent *testCertificate) *testCertificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+	signer, signerKey := template, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCertificate{
+		cert:    cert,
+		key:     key,
+		certPEM: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		keyPEM:  string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})),
+	}
+}
+
+// newMutualTLSServer starts a server with a certificate issued by a new CA,
+// only accepting clients with a certificate from the same CA.
+func newMutualTLSServer(t *testing.T) (*httptest.Server, *testCertificate, *testCertificate) {
+	t.Helper()
+
+	ca := newTestCertificate(t, &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "tf_acc CA"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil)
+	serverCert := newTestCertificate(t, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "spade"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, ca)
+	clientCert := newTestCertificate(t, &x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, ca)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca.cert)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{serverCert.cert.Raw}, PrivateKey: serverCert.key}},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	return server, ca, clientCert
+}
+
+func TestNewHTTPClientTLS(t *testing.T) {
+	server, ca, clientCert := newMutualTLSServer(t)
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, []byte(ca.certPEM), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name    string
+		data    SpadeProviderModel
+		success bool
+	}{
+		{
+			name: "CA and client certificate",
+			data: SpadeProviderModel{
+				CACertPEM:  types.StringValue(ca.certPEM),
+				ClientCert: types.StringValue(clientCert.certPEM),
+				ClientKey:  types.StringValue(clientCert.keyPEM),
+			},
+			success: true,
+		},
+		{
+			name: "CA file",
+			data: SpadeProviderModel{
+				CACertFile: types.StringValue(caFile),
+				ClientCert: types.StringValue(clientCert.certPEM),
+				ClientKey:  types.StringValue(clientCert.keyPEM),
+			},
+			success: true,
+		},
+		{
+			name: "insecure",
+			data: SpadeProviderModel{
+				InsecureSkipVerify: types.BoolValue(true),
+				ClientCert:         types.StringValue(clientCert.certPEM),
+				ClientKey:          types.StringValue(clientCert.keyPEM),
+			},
+			success: true,
+		},
+		{
+			name: "unknown CA",
+			data: SpadeProviderModel{
+				ClientCert: types.StringValue(clientCert.certPEM),
+				ClientKey:  types.StringValue(clientCert.keyPEM),
+			},
+		},
+		{
+			name: "no client certificate",
+			data: SpadeProviderModel{CACertPEM: types.StringValue(ca.certPEM)},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			client := newHTTPClient(tc.data, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+
+			resp, err := client.Get(server.URL)
+			if err == nil {
+				resp.Body.Close()
+			}
+			if (err == nil) != tc.success {
+				t.Errorf("expected success %t, got error: %v", tc.success, err)
+			}
+		})
+	}
+}
+
+func TestNewHTTPClientInvalidCertificates(t *testing.T) {
+	cases := []struct {
+		name    string
+		data    SpadeProviderModel
+		path    path.Path
+		summary string
+	}{
+		{
+			name:    "CA not PEM",
+			data:    SpadeProviderModel{CACertPEM: types.StringValue("not a certificate")},
+			path:    path.Root("ca_cert_pem"),
+			summary: "CA Certificate Error",
+		},
+		{
+			name:    "CA file missing",
+			data:    SpadeProviderModel{CACertFile: types.StringValue(filepath.Join(t.TempDir(), "missing.pem"))},
+			path:    path.Root("ca_cert_file"),
+			summary: "CA Certificate Error",
+		},
+		{
+			name: "client certificate not PEM",
+			data: SpadeProviderModel{
+				ClientCert: types.StringValue("not a certificate"),
+				ClientKey:  types.StringValue("not a key"),
+			},
+			path:    path.Root("client_cert"),
+			summary: "Client Certificate Error",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			if client := newHTTPClient(tc.data, &diags); client != nil {
+				t.Error("expected no client to be returned")
+			}
+			testCheckDiagnostic(t, diags, tc.path, tc.summary)
+		})
+	}
+}
+
+func TestNewHTTPClientProxy(t *testing.T) {
+	// the proxy answers in place of Spade, recording the requested host
+	hosts := make(chan string, 1)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hosts <- r.URL.Host
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer proxy.Close()
+
+	var diags diag.Diagnostics
+	client := newHTTPClient(SpadeProviderModel{ProxyURL: types.StringValue(proxy.URL)}, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	resp, err := client.Get("http://spade.invalid/api/v1/variables")
+	if err != nil {
+		t.Fatalf("request through the proxy failed: %s", err)
+	}
+	resp.Body.Close()
+	if host := <-hosts; host != "spade.invalid" {
+		t.Errorf("expected the proxy to receive the request for spade.invalid, got %q", host)
+	}
+}
+
+func TestNewHTTPClientInvalidSettings(t *testing.T) {
+	cases := []struct {
+		name    string
+		data    SpadeProviderModel
+		path    path.Path
+		summary string
+	}{
+		{
+			name:    "proxy without host",
+			data:    SpadeProviderModel{ProxyURL: types.StringValue("proxy.example.com")},
+			path:    path.Root("proxy_url"),
+			summary: "Invalid Proxy URL",
+		},
+		{
+			name:    "timeout without unit",
+			data:    SpadeProviderModel{RequestTimeout: types.StringValue("30")},
+			path:    path.Root("request_timeout"),
+			summary: "Invalid Duration",
+		},
+		{
+			name:    "negative timeout",
+			data:    SpadeProviderModel{RequestTimeout: types.StringValue("-1s")},
+			path:    path.Root("request_timeout"),
+			summary: "Invalid Duration",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			if client := newHTTPClient(tc.data, &diags); client != nil {
+				t.Error("expected no client to be returned")
+			}
+			testCheckDiagnostic(t, diags, tc.path, tc.summary)
+		})
+	}
+}
+
+func TestNewHTTPClientTimeout(t *testing.T) {
+	var diags diag.Diagnostics
+	client := newHTTPClient(SpadeProviderModel{RequestTimeout: types.StringValue("90s")}, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if client.Timeout != 90*time.Second {
+		t.Errorf("expected a timeout of 90s, got %s", client.Timeout)
+	}
+}
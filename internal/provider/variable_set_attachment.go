@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	spade "terraform-provider-spade/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &variableSetAttachmentResource{}
+var _ resource.ResourceWithImportState = &variableSetAttachmentResource{}
+
+// variableSetAttachmentResource implements the resources attaching a variable
+// set to an object holding a list of variable sets, such as a process. The
+// object is identified by the attribute named after it, e.g. process.
+type variableSetAttachmentResource struct {
+	Client *spade.SpadeClient
+
+	// object is the type of the object, e.g. "process".
+	object string
+	// mutexKey returns the spadeMutexKV key guarding the object.
+	mutexKey func(id int64) string
+	// readVariableSets returns the variable sets of the object, with found
+	// unset if the object does not exist.
+	readVariableSets func(ctx context.Context, client *spade.SpadeClient, id int64) (variableSets []int64, found bool, err error)
+	// updateVariableSets replaces the variable sets of the object, leaving
+	// its other fields untouched.
+	updateVariableSets func(ctx context.Context, client *spade.SpadeClient, id int64, variableSets []int64) error
+}
+
+// spadeVariableSetAttachmentFieldPaths maps the fields of the requests
+// changing variable sets to the attributes they are set from.
+var spadeVariableSetAttachmentFieldPaths = apiFieldPaths{
+	"variable_sets": path.Root("variable_set"),
+}
+
+// attributeGetter is implemented by the plan and the state of requests.
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, p path.Path, target interface{}) diag.Diagnostics
+}
+
+func (r *variableSetAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.object + "_variable_set_attachment"
+}
+
+func (r *variableSetAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: fmt.Sprintf("Attaches a variable set to a %[1]s within Spade. The other variable "+
+			"sets of the %[1]s are left untouched", r.object),
+
+		Attributes: map[string]schema.Attribute{
+			r.object: schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Identifier of the %s", r.object),
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"variable_set": schema.Int64Attribute{
+				MarkdownDescription: "Identifier of the variable set",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("Identifiers of the %s and of the variable set, separated by a slash", r.object),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *variableSetAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*spade.SpadeClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *spade.SpadeClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.Client = client
+}
+
+func (r *variableSetAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data
+	object, variableSet := r.get(ctx, req.Plan, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.variableSets(object).set(ctx, variableSet, true)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to attach variable set to "+r.object, err, spadeVariableSetAttachmentFieldPaths)
+		return
+	}
+
+	// Save data into Terraform state
+	r.set(ctx, &resp.State, object, variableSet, &resp.Diagnostics)
+}
+
+func (r *variableSetAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data
+	object, variableSet := r.get(ctx, req.State, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variableSets, found, err := r.readVariableSets(ctx, r.Client, object)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to read "+r.object, err, nil)
+		return
+	}
+	if !found || !slices.Contains(variableSets, variableSet) {
+		// Object or attachment no longer exists, remove from Terraform state
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	r.set(ctx, &resp.State, object, variableSet, &resp.Diagnostics)
+}
+
+func (r *variableSetAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require replacement, so there is nothing to update
+	object, variableSet := r.get(ctx, req.Plan, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	r.set(ctx, &resp.State, object, variableSet, &resp.Diagnostics)
+}
+
+func (r *variableSetAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data
+	object, variableSet := r.get(ctx, req.State, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.variableSets(object).set(ctx, variableSet, false)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Unable to detach variable set from "+r.object, err, spadeVariableSetAttachmentFieldPaths)
+		return
+	}
+}
+
+func (r *variableSetAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	objectId, variableSetId, found := strings.Cut(req.ID, "/")
+	object, err := strconv.ParseInt(objectId, 10, 64)
+	var variableSet int64
+	if err == nil {
+		variableSet, err = strconv.ParseInt(variableSetId, 10, 64)
+	}
+	if err != nil || !found {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected <%s id>/<variable set id>, got: %s", r.object, req.ID),
+		)
+		return
+	}
+
+	r.set(ctx, &resp.State, object, variableSet, &resp.Diagnostics)
+}
+
+// get reads the identifiers of the object and of the variable set from the
+// plan or state.
+func (r *variableSetAttachmentResource) get(ctx context.Context, data attributeGetter, diags *diag.Diagnostics) (object, variableSet int64) {
+	var objectValue, variableSetValue types.Int64
+	diags.Append(data.GetAttribute(ctx, path.Root(r.object), &objectValue)...)
+	diags.Append(data.GetAttribute(ctx, path.Root("variable_set"), &variableSetValue)...)
+	return objectValue.ValueInt64(), variableSetValue.ValueInt64()
+}
+
+// set saves the attachment of the variable set to the object into state.
+func (r *variableSetAttachmentResource) set(ctx context.Context, state *tfsdk.State, object, variableSet int64, diags *diag.Diagnostics) {
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%d/%d", object, variableSet))...)
+	diags.Append(state.SetAttribute(ctx, path.Root(r.object), object)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("variable_set"), variableSet)...)
+}
+
+// variableSets returns the variable sets of the object, as a list
+// attachments are added to and removed from.
+func (r *variableSetAttachmentResource) variableSets(object int64) memberList {
+	return memberList{
+		name:     r.object,
+		id:       object,
+		mutexKey: r.mutexKey(object),
+		read: func(ctx context.Context) ([]int64, bool, error) {
+			return r.readVariableSets(ctx, r.Client, object)
+		},
+		update: func(ctx context.Context, variableSets []int64) error {
+			return r.updateVariableSets(ctx, r.Client, object, variableSets)
+		},
+	}
+}
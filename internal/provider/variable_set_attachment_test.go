@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	spade "terraform-provider-spade/internal/client"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testAccVariableSetAttachmentObject describes the object a variable set
+// attachment resource attaches variable sets to.
+type testAccVariableSetAttachmentObject struct {
+	// object is the type of the object, e.g. "process".
+	object string
+	// config declares the spade_<object>.test resource listing the owned
+	// variable set, ignoring changes to its variable sets, along with the
+	// objects it needs. It is formatted with the code of the object.
+	config string
+	// readVariableSets and updateVariableSets read and replace the variable
+	// sets of the object through the Spade API.
+	readVariableSets   func(ctx context.Context, client *spade.SpadeClient, id int64) ([]int64, error)
+	updateVariableSets func(ctx context.Context, client *spade.SpadeClient, id int64, variableSets []int64) error
+}
+
+// testAccVariableSetAttachmentResource tests the spade_<object>_variable_set_attachment
+// resource: an object lists an owned variable set while the resource attaches
+// another one.
+func testAccVariableSetAttachmentResource(t *testing.T, o testAccVariableSetAttachmentObject) {
+	code := acctest.RandomWithPrefix("tf_acc_" + o.object)
+	resourceName := fmt.Sprintf("spade_%s_variable_set_attachment.test", o.object)
+	var object, owned, attached int64
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: o.resourceConfig(code, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, o.object, "spade_"+o.object+".test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "variable_set", "spade_variable_set.attached", "id"),
+					testAccCheckResourceId("spade_"+o.object+".test", &object),
+					testAccCheckResourceId("spade_variable_set.owned", &owned),
+					testAccCheckResourceId("spade_variable_set.attached", &attached),
+					o.checkVariableSet(t, &object, &owned, true),
+					o.checkVariableSet(t, &object, &attached, true),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return fmt.Sprintf("%d/%d", object, attached), nil
+				},
+				ImportStateVerify: true,
+			},
+			// Attachment removed outside of Terraform
+			{
+				PreConfig: func() {
+					if err := o.updateVariableSets(context.Background(), testAccClient(t), object, []int64{owned}); err != nil {
+						t.Fatalf("unable to update %s %d: %s", o.object, object, err)
+					}
+				},
+				Config: o.resourceConfig(code, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				Check: o.checkVariableSet(t, &object, &attached, true),
+			},
+			// Delete only detaches the variable set of the resource
+			{
+				Config: o.resourceConfig(code, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					o.checkVariableSet(t, &object, &owned, true),
+					o.checkVariableSet(t, &object, &attached, false),
+				),
+			},
+		},
+	})
+}
+
+// testAccVariableSetAttachmentResourceInvalidImport tests importing the
+// spade_<object>_variable_set_attachment resource with a malformed identifier.
+func testAccVariableSetAttachmentResourceInvalidImport(t *testing.T, object string) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "spade_%[1]s_variable_set_attachment" "test" {
+  %-12[1]s = 12
+  variable_set = 34
+}
+`, object),
+				ResourceName:  fmt.Sprintf("spade_%s_variable_set_attachment.test", object),
+				ImportState:   true,
+				ImportStateId: "12",
+				ExpectError:   regexp.MustCompile(fmt.Sprintf(`Expected <%s id>/<variable set id>, got:\s+12`, object)),
+			},
+		},
+	})
+}
+
+// checkVariableSet checks whether the variable set is attached to the
+// object, as seen by the Spade API.
+func (o testAccVariableSetAttachmentObject) checkVariableSet(t *testing.T, object, variableSet *int64, attached bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		variableSets, err := o.readVariableSets(context.Background(), testAccClient(t), *object)
+		if err != nil {
+			return err
+		}
+		if slices.Contains(variableSets, *variableSet) != attached {
+			return fmt.Errorf("expected attachment of variable set %d to %s %d to be %t, got variable sets: %v", *variableSet, o.object, *object, attached, variableSets)
+		}
+		return nil
+	}
+}
+
+// resourceConfig declares the object with the owned variable set, while the
+// attached variable set is added by the attachment resource, unless
+// withAttachment is unset.
+func (o testAccVariableSetAttachmentObject) resourceConfig(code string, withAttachment bool) string {
+	config := fmt.Sprintf(`
+resource "spade_variable_set" "owned" {
+  name = "%[1]s-owned"
+}
+
+resource "spade_variable_set" "attached" {
+  name = "%[1]s-attached"
+}
+`, code) + fmt.Sprintf(o.config, code)
+	if withAttachment {
+		config += fmt.Sprintf(`
+resource "spade_%[1]s_variable_set_attachment" "test" {
+  %-12[1]s = spade_%[1]s.test.id
+  variable_set = spade_variable_set.attached.id
+}
+`, o.object)
+	}
+	return config
+}
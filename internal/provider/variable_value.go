@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Types of the value of a spade_variable. Spade stores every value as a
+// string, the type only tells the provider how to serialise it.
+const (
+	variableTypeString = "string"
+	variableTypeNumber = "number"
+	variableTypeBool   = "bool"
+	variableTypeJSON   = "json"
+)
+
+// numberPrecision is the precision of numbers in Terraform, used when parsing
+// numbers back so that they compare equal to the configured ones.
+const numberPrecision = 512
+
+// apiValue serialises the value of the variable, taken from whichever value
+// attribute is set, into the string stored by Spade. Numbers are written in
+// decimal notation and JSON is compacted.
+func (m SpadeVariableResourceModel) apiValue() (string, error) {
+	switch {
+	case !m.ValueNumber.IsNull():
+		return m.ValueNumber.ValueBigFloat().Text('f', -1), nil
+	case !m.ValueBool.IsNull():
+		return strconv.FormatBool(m.ValueBool.ValueBool()), nil
+	case !m.ValueJSON.IsNull():
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, []byte(m.ValueJSON.ValueString())); err != nil {
+			return "", err
+		}
+		return buf.String(), nil
+	default:
+		return m.Value.ValueString(), nil
+	}
+}
+
+// fieldPaths maps the fields of variable requests to the attributes they are
+// set from, with value mapped to whichever value attribute is set.
+func (m SpadeVariableResourceModel) fieldPaths() apiFieldPaths {
+	paths := sameFieldPaths("name", "description", "is_secret")
+	switch {
+	case !m.ValueNumber.IsNull():
+		paths["value"] = path.Root("value_number")
+	case !m.ValueBool.IsNull():
+		paths["value"] = path.Root("value_bool")
+	case !m.ValueJSON.IsNull():
+		paths["value"] = path.Root("value_json")
+	default:
+		paths["value"] = path.Root("value")
+	}
+	return paths
+}
+
+// setValue parses value, as stored by Spade, into the value attribute
+// matching the type of the variable. Variables of unknown type, e.g. after an
+// import, are read as strings. A value which cannot be parsed, because it was
+// changed outside of Terraform, is read as null so that an update is planned.
+func (m *SpadeVariableResourceModel) setValue(value string) {
+	if m.Type.IsNull() || m.Type.IsUnknown() {
+		m.Type = types.StringValue(variableTypeString)
+	}
+	m.Value = types.StringNull()
+	m.ValueNumber = types.NumberNull()
+	m.ValueBool = types.BoolNull()
+	m.ValueJSON = jsontypes.NewNormalizedNull()
+
+	switch m.Type.ValueString() {
+	case variableTypeNumber:
+		if number, _, err := big.ParseFloat(value, 10, numberPrecision, big.ToNearestEven); err == nil {
+			m.ValueNumber = types.NumberValue(number)
+		}
+	case variableTypeBool:
+		if b, err := strconv.ParseBool(value); err == nil {
+			m.ValueBool = types.BoolValue(b)
+		}
+	case variableTypeJSON:
+		if json.Valid([]byte(value)) {
+			m.ValueJSON = jsontypes.NewNormalizedValue(value)
+		}
+	default:
+		m.Value = types.StringValue(value)
+	}
+}
+
+// variableTypeFromConfig is a plan modifier setting the type of a
+// spade_variable from the value attribute set in the configuration.
+type variableTypeFromConfig struct{}
+
+func (m variableTypeFromConfig) Description(ctx context.Context) string {
+	return "The type is set from the value attribute in the configuration."
+}
+
+func (m variableTypeFromConfig) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m variableTypeFromConfig) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	var valueNumber types.Number
+	var valueBool types.Bool
+	var valueJSON jsontypes.Normalized
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("value_number"), &valueNumber)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("value_bool"), &valueBool)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("value_json"), &valueJSON)...)
+
+	variableType := variableTypeString
+	switch {
+	case !valueNumber.IsNull():
+		variableType = variableTypeNumber
+	case !valueBool.IsNull():
+		variableType = variableTypeBool
+	case !valueJSON.IsNull():
+		variableType = variableTypeJSON
+	}
+	resp.PlanValue = types.StringValue(variableType)
+}
@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSpadeVariableFieldPaths(t *testing.T) {
+	cases := []struct {
+		name     string
+		model    SpadeVariableResourceModel
+		expected path.Path
+	}{
+		{
+			name:     "string",
+			model:    SpadeVariableResourceModel{Value: types.StringValue("eu-west-1")},
+			expected: path.Root("value"),
+		},
+		{
+			name:     "number",
+			model:    SpadeVariableResourceModel{ValueNumber: types.NumberValue(big.NewFloat(3))},
+			expected: path.Root("value_number"),
+		},
+		{
+			name:     "bool",
+			model:    SpadeVariableResourceModel{ValueBool: types.BoolValue(true)},
+			expected: path.Root("value_bool"),
+		},
+		{
+			name:     "json",
+			model:    SpadeVariableResourceModel{ValueJSON: jsontypes.NewNormalizedValue(`{"a":1}`)},
+			expected: path.Root("value_json"),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.model.fieldPaths()["value"]; !got.Equal(tc.expected) {
+				t.Errorf("expected value to be reported against %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+// testNumber parses s the way Terraform does, at its precision.
+func testNumber(t *testing.T, s string) types.Number {
+	t.Helper()
+	number, _, err := big.ParseFloat(s, 10, numberPrecision, big.ToNearestEven)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types.NumberValue(number)
+}
+
+func TestSpadeVariableApiValue(t *testing.T) {
+	cases := []struct {
+		name     string
+		model    SpadeVariableResourceModel
+		expected string
+		err      bool
+	}{
+		{
+			name:     "string",
+			model:    SpadeVariableResourceModel{Value: types.StringValue("eu-west-1")},
+			expected: "eu-west-1",
+		},
+		{
+			name:     "number in exponent notation",
+			model:    SpadeVariableResourceModel{ValueNumber: testNumber(t, "1e-1")},
+			expected: "0.1",
+		},
+		{
+			name:     "large number",
+			model:    SpadeVariableResourceModel{ValueNumber: testNumber(t, "123456789012345678901234567890")},
+			expected: "123456789012345678901234567890",
+		},
+		{
+			name:     "negative number",
+			model:    SpadeVariableResourceModel{ValueNumber: testNumber(t, "-2.5")},
+			expected: "-2.5",
+		},
+		{
+			name:     "bool",
+			model:    SpadeVariableResourceModel{ValueBool: types.BoolValue(false)},
+			expected: "false",
+		},
+		{
+			name:     "json",
+			model:    SpadeVariableResourceModel{ValueJSON: jsontypes.NewNormalizedValue("{\n  \"b\": [1, 2],\n  \"a\": \"x y\"\n}")},
+			expected: `{"b":[1,2],"a":"x y"}`,
+		},
+		{
+			name:  "invalid json",
+			model: SpadeVariableResourceModel{ValueJSON: jsontypes.NewNormalizedValue(`{"a":`)},
+			err:   true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.model.apiValue()
+			if tc.err {
+				if err == nil {
+					t.Errorf("expected an error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestSpadeVariableSetValue(t *testing.T) {
+	cases := []struct {
+		name         string
+		variableType types.String
+		value        string
+		expected     SpadeVariableResourceModel
+	}{
+		{
+			name:         "string",
+			variableType: types.StringValue(variableTypeString),
+			value:        "eu-west-1",
+			expected:     SpadeVariableResourceModel{Type: types.StringValue(variableTypeString), Value: types.StringValue("eu-west-1")},
+		},
+		{
+			name:         "number",
+			variableType: types.StringValue(variableTypeNumber),
+			value:        "0.1",
+			// equal to the number configured as 1e-1
+			expected: SpadeVariableResourceModel{Type: types.StringValue(variableTypeNumber), ValueNumber: testNumber(t, "1e-1")},
+		},
+		{
+			name:         "bool",
+			variableType: types.StringValue(variableTypeBool),
+			value:        "true",
+			expected:     SpadeVariableResourceModel{Type: types.StringValue(variableTypeBool), ValueBool: types.BoolValue(true)},
+		},
+		{
+			name:         "json",
+			variableType: types.StringValue(variableTypeJSON),
+			value:        `{"b":[1,2],"a":"x y"}`,
+			expected:     SpadeVariableResourceModel{Type: types.StringValue(variableTypeJSON), ValueJSON: jsontypes.NewNormalizedValue(`{"b":[1,2],"a":"x y"}`)},
+		},
+		{
+			name:         "unparsable number",
+			variableType: types.StringValue(variableTypeNumber),
+			value:        "ten",
+			expected:     SpadeVariableResourceModel{Type: types.StringValue(variableTypeNumber)},
+		},
+		{
+			name:         "unparsable bool",
+			variableType: types.StringValue(variableTypeBool),
+			value:        "maybe",
+			expected:     SpadeVariableResourceModel{Type: types.StringValue(variableTypeBool)},
+		},
+		{
+			name:         "unparsable json",
+			variableType: types.StringValue(variableTypeJSON),
+			value:        `{"a":`,
+			expected:     SpadeVariableResourceModel{Type: types.StringValue(variableTypeJSON)},
+		},
+		{
+			name:         "null type after import",
+			variableType: types.StringNull(),
+			value:        "42",
+			expected:     SpadeVariableResourceModel{Type: types.StringValue(variableTypeString), Value: types.StringValue("42")},
+		},
+		{
+			name:         "unknown type",
+			variableType: types.StringUnknown(),
+			value:        "true",
+			expected:     SpadeVariableResourceModel{Type: types.StringValue(variableTypeString), Value: types.StringValue("true")},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// the previous value attributes are replaced
+			model := SpadeVariableResourceModel{
+				Type:        tc.variableType,
+				Value:       types.StringValue("previous"),
+				ValueNumber: types.NumberValue(big.NewFloat(1)),
+				ValueBool:   types.BoolValue(false),
+				ValueJSON:   jsontypes.NewNormalizedValue(`{}`),
+			}
+			model.setValue(tc.value)
+
+			if !model.Type.Equal(tc.expected.Type) {
+				t.Errorf("expected type %s, got %s", tc.expected.Type, model.Type)
+			}
+			if !model.Value.Equal(tc.expected.Value) {
+				t.Errorf("expected value %s, got %s", tc.expected.Value, model.Value)
+			}
+			if !model.ValueNumber.Equal(tc.expected.ValueNumber) {
+				t.Errorf("expected value_number %s, got %s", tc.expected.ValueNumber, model.ValueNumber)
+			}
+			if !model.ValueBool.Equal(tc.expected.ValueBool) {
+				t.Errorf("expected value_bool %s, got %s", tc.expected.ValueBool, model.ValueBool)
+			}
+			if !model.ValueJSON.Equal(tc.expected.ValueJSON) {
+				t.Errorf("expected value_json %s, got %s", tc.expected.ValueJSON, model.ValueJSON)
+			}
+		})
+	}
+}
@@ -0,0 +1,667 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package spadetest provides an in-memory implementation of the Spade API for
+// testing the client and the provider without a live Spade instance.
+package spadetest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultEmail and DefaultPassword are the credentials accepted by a new
+	// Server.
+	DefaultEmail    = "admin@example.com"
+	DefaultPassword = "password"
+
+	// DefaultPageSize is the number of objects returned per page of a list.
+	DefaultPageSize = 100
+
+	// DefaultAccessTokenLifetime and DefaultRefreshTokenLifetime are the
+	// lifetimes of the tokens issued by a new Server, the defaults of
+	// SimpleJWT.
+	DefaultAccessTokenLifetime  = 5 * time.Minute
+	DefaultRefreshTokenLifetime = 24 * time.Hour
+)
+
+// Server is an in-memory Spade API served over HTTP. It implements token
+// authentication and the create, read, update, delete, list and search
+// endpoints of every object managed by the provider, with the request and
+// response bodies used by the client. The values of secret variables are
+// served blank, as the spade_secret_variable resource expects of Spade
+// responses. Status codes, error bodies and validation follow the defaults of
+// Django REST Framework and SimpleJWT, and are not checked against Spade
+// itself.
+type Server struct {
+	*httptest.Server
+
+	// Email and Password are the credentials accepted by the token endpoint.
+	Email    string
+	Password string
+	// PageSize is the number of objects returned per page of a list, unless
+	// the request sets page_size.
+	PageSize int
+	// AccessTokenLifetime and RefreshTokenLifetime set the exp claim of the
+	// JWTs issued by the token endpoints. Tokens are rejected once expired.
+	AccessTokenLifetime  time.Duration
+	RefreshTokenLifetime time.Duration
+
+	mu          sync.Mutex
+	collections map[string]*collection
+	// accessTokens and refreshTokens map the tokens issued to their expiry
+	accessTokens  map[string]time.Time
+	refreshTokens map[string]time.Time
+	lastToken     int
+	failures      []failure
+}
+
+type failure struct {
+	status     int
+	count      int
+	retryAfter time.Duration
+}
+
+// NewServer starts a Server with empty collections. It should be closed by
+// calling Close once done.
+func NewServer() *Server {
+	s := &Server{
+		Email:                DefaultEmail,
+		Password:             DefaultPassword,
+		PageSize:             DefaultPageSize,
+		AccessTokenLifetime:  DefaultAccessTokenLifetime,
+		RefreshTokenLifetime: DefaultRefreshTokenLifetime,
+		collections:          newCollections(),
+		accessTokens:         map[string]time.Time{},
+		refreshTokens:        map[string]time.Time{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// ExpireTokens revokes every access token issued so far, so that the next
+// authenticated request is rejected until the token is refreshed.
+func (s *Server) ExpireTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.accessTokens = map[string]time.Time{}
+}
+
+// ExpireRefreshTokens revokes every refresh token issued so far, so that
+// refreshing an access token fails until the client logs in again.
+func (s *Server) ExpireRefreshTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.refreshTokens = map[string]time.Time{}
+}
+
+// FailRequests makes the next count requests fail with status, before they
+// are authenticated or processed.
+func (s *Server) FailRequests(status, count int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures = append(s.failures, failure{status: status, count: count})
+}
+
+// ThrottleRequests makes the next count requests fail with 429, asking the
+// client to retry after the given duration, rounded down to seconds.
+func (s *Server) ThrottleRequests(count int, retryAfter time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures = append(s.failures, failure{status: http.StatusTooManyRequests, count: count, retryAfter: retryAfter})
+}
+
+// Object returns a copy of the object with the given id in a collection, e.g.
+// "variables", or nil if it does not exist.
+func (s *Server) Object(collection string, id int64) map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.collections[collection]
+	if !ok {
+		return nil
+	}
+	obj, ok := c.objects[id]
+	if !ok {
+		return nil
+	}
+	return copyObject(obj)
+}
+
+// UpdateObject changes fields of an object directly, bypassing validation, to
+// simulate changes made outside of the API such as in the Spade admin UI. It
+// reports whether the object exists.
+func (s *Server) UpdateObject(collection string, id int64, changes map[string]interface{}) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.collections[collection]
+	if !ok || c.objects[id] == nil {
+		return false
+	}
+	obj := c.objects[id]
+	for field, value := range changes {
+		obj[field] = value
+	}
+	c.store(obj)
+	return true
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.failures) > 0 {
+		f := &s.failures[0]
+		status := f.status
+		if f.retryAfter > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(f.retryAfter/time.Second)))
+		}
+		f.count--
+		if f.count <= 0 {
+			s.failures = s.failures[1:]
+		}
+		writeDetail(w, status, http.StatusText(status))
+		return
+	}
+
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	switch path {
+	case "/api/v1/token":
+		s.serveLogin(w, r)
+		return
+	case "/api/v1/token/refresh":
+		s.serveRefresh(w, r)
+		return
+	}
+
+	if !isValid(s.accessTokens, strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")) {
+		writeDetail(w, http.StatusUnauthorized, "Given token not valid for any token type")
+		return
+	}
+
+	name, id, hasId := strings.Cut(strings.TrimPrefix(path, "/api/v1/"), "/")
+	c, ok := s.collections[name]
+	if !strings.HasPrefix(path, "/api/v1/") || !ok {
+		writeDetail(w, http.StatusNotFound, "Not found.")
+		return
+	}
+	if !hasId {
+		switch r.Method {
+		case http.MethodGet:
+			s.serveList(w, r, c)
+		case http.MethodPost:
+			s.serveCreate(w, r, c)
+		default:
+			writeDetail(w, http.StatusMethodNotAllowed, fmt.Sprintf("Method \"%s\" not allowed.", r.Method))
+		}
+		return
+	}
+
+	objId, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || c.objects[objId] == nil {
+		writeDetail(w, http.StatusNotFound, "Not found.")
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, c.serve(c.objects[objId]))
+	case http.MethodPut, http.MethodPatch:
+		s.serveUpdate(w, r, c, objId)
+	case http.MethodDelete:
+		s.delete(c, objId)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeDetail(w, http.StatusMethodNotAllowed, fmt.Sprintf("Method \"%s\" not allowed.", r.Method))
+	}
+}
+
+func (s *Server) serveLogin(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Email    string `json:"email"`
+		Password string `json:"password"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeDetail(w, http.StatusBadRequest, "JSON parse error - "+err.Error())
+		return
+	}
+	if req.Email != s.Email || req.Password != s.Password {
+		writeDetail(w, http.StatusUnauthorized, "No active account found with the given credentials")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.issueTokens())
+}
+
+func (s *Server) serveRefresh(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Refresh string `json:"refresh"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeDetail(w, http.StatusBadRequest, "JSON parse error - "+err.Error())
+		return
+	}
+	if !isValid(s.refreshTokens, req.Refresh) {
+		writeDetail(w, http.StatusUnauthorized, "Token is invalid or expired")
+		return
+	}
+	// refresh tokens are rotated, as with SimpleJWT's ROTATE_REFRESH_TOKENS
+	delete(s.refreshTokens, req.Refresh)
+	writeJSON(w, http.StatusOK, s.issueTokens())
+}
+
+func (s *Server) issueTokens() map[string]interface{} {
+	s.lastToken++
+	access, accessExpiry := newToken("access", s.lastToken, s.AccessTokenLifetime)
+	refresh, refreshExpiry := newToken("refresh", s.lastToken, s.RefreshTokenLifetime)
+	s.accessTokens[access] = accessExpiry
+	s.refreshTokens[refresh] = refreshExpiry
+	return map[string]interface{}{
+		"access":  access,
+		"refresh": refresh,
+	}
+}
+
+// newToken returns an unsigned JWT shaped like those of SimpleJWT, with an exp
+// claim lifetime from now, along with its expiry.
+func newToken(tokenType string, id int, lifetime time.Duration) (string, time.Time) {
+	expiry := time.Now().Add(lifetime)
+	claims, err := json.Marshal(map[string]interface{}{
+		"token_type": tokenType,
+		"exp":        expiry.Unix(),
+		"jti":        strconv.Itoa(id),
+	})
+	if err != nil {
+		panic(err)
+	}
+	encode := base64.RawURLEncoding.EncodeToString
+	return encode([]byte(`{"alg":"HS256","typ":"JWT"}`)) + "." + encode(claims) + "." + encode([]byte("spadetest")), expiry
+}
+
+// isValid reports whether token was issued and has not expired yet.
+func isValid(tokens map[string]time.Time, token string) bool {
+	expiry, ok := tokens[token]
+	return ok && time.Now().Before(expiry)
+}
+
+func (s *Server) serveList(w http.ResponseWriter, r *http.Request, c *collection) {
+	query := r.URL.Query()
+	search := strings.ToLower(query.Get("search"))
+
+	results := []map[string]interface{}{}
+	for _, id := range c.ids() {
+		obj := c.objects[id]
+		if search == "" || c.matches(obj, search) {
+			results = append(results, c.serve(obj))
+		}
+	}
+
+	pageSize := s.PageSize
+	if value := query.Get("page_size"); value != "" {
+		size, err := strconv.Atoi(value)
+		if err == nil && size > 0 {
+			pageSize = size
+		}
+	}
+	page := 1
+	if value := query.Get("page"); value != "" {
+		var err error
+		page, err = strconv.Atoi(value)
+		if err != nil || page < 1 || (page-1)*pageSize >= len(results) && page != 1 {
+			writeDetail(w, http.StatusNotFound, "Invalid page.")
+			return
+		}
+	}
+
+	start := (page - 1) * pageSize
+	end := min(start+pageSize, len(results))
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"count":    len(results),
+		"next":     pageLink(r, page+1, end < len(results)),
+		"previous": pageLink(r, page-1, page > 1),
+		"results":  results[start:end],
+	})
+}
+
+// pageLink returns the absolute link to another page of a list, or nil when
+// there is no such page.
+func pageLink(r *http.Request, page int, exists bool) interface{} {
+	if !exists {
+		return nil
+	}
+	query := r.URL.Query()
+	query.Set("page", strconv.Itoa(page))
+	link := url.URL{
+		Scheme:   "http",
+		Host:     r.Host,
+		Path:     r.URL.Path,
+		RawQuery: query.Encode(),
+	}
+	return link.String()
+}
+
+func (s *Server) serveCreate(w http.ResponseWriter, r *http.Request, c *collection) {
+	var obj map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&obj); err != nil {
+		writeDetail(w, http.StatusBadRequest, "JSON parse error - "+err.Error())
+		return
+	}
+	for field, value := range c.defaults {
+		if _, ok := obj[field]; !ok {
+			obj[field] = value
+		}
+	}
+	if errs := s.validate(c, 0, obj); len(errs) > 0 {
+		writeJSON(w, http.StatusBadRequest, errs)
+		return
+	}
+
+	c.lastId++
+	obj["id"] = c.lastId
+	obj = c.store(obj)
+	writeJSON(w, http.StatusCreated, obj)
+}
+
+func (s *Server) serveUpdate(w http.ResponseWriter, r *http.Request, c *collection, id int64) {
+	var changes map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&changes); err != nil {
+		writeDetail(w, http.StatusBadRequest, "JSON parse error - "+err.Error())
+		return
+	}
+	obj := copyObject(c.objects[id])
+	for field, value := range changes {
+		if field == "id" {
+			continue
+		}
+		obj[field] = value
+	}
+	if errs := s.validate(c, id, obj); len(errs) > 0 {
+		writeJSON(w, http.StatusBadRequest, errs)
+		return
+	}
+
+	obj = c.store(obj)
+	writeJSON(w, http.StatusOK, obj)
+}
+
+// validate checks obj the way Django REST Framework serializers do by
+// default, returning the errors per field.
+func (s *Server) validate(c *collection, id int64, obj map[string]interface{}) map[string][]string {
+	errs := map[string][]string{}
+	for _, field := range c.required {
+		if value, ok := obj[field].(string); !ok || value == "" {
+			errs[field] = append(errs[field], "This field may not be blank.")
+		}
+	}
+	for _, field := range c.unique {
+		for otherId, other := range c.objects {
+			if otherId != id && reflect.DeepEqual(other[field], obj[field]) {
+				errs[field] = append(errs[field], fmt.Sprintf("%s with this %s already exists.", c.singular, field))
+			}
+		}
+	}
+	for field, target := range c.references {
+		for _, ref := range referencedIds(obj[field]) {
+			if ref == nil {
+				continue
+			}
+			if refId, ok := toId(ref); !ok || s.collections[target].objects[refId] == nil {
+				pk, _ := json.Marshal(ref)
+				errs[field] = append(errs[field], fmt.Sprintf("Invalid pk \"%s\" - object does not exist.", pk))
+			}
+		}
+	}
+	return errs
+}
+
+// delete removes an object, along with any reference to it from the lists of
+// other objects.
+func (s *Server) delete(c *collection, id int64) {
+	delete(c.objects, id)
+	for _, other := range s.collections {
+		for field, target := range other.references {
+			if s.collections[target] != c {
+				continue
+			}
+			for _, obj := range other.objects {
+				refs, ok := obj[field].([]interface{})
+				if !ok {
+					continue
+				}
+				kept := []interface{}{}
+				for _, ref := range refs {
+					if refId, _ := toId(ref); refId != id {
+						kept = append(kept, ref)
+					}
+				}
+				obj[field] = kept
+			}
+		}
+	}
+}
+
+// referencedIds returns the ids held by a field referencing other objects,
+// which is either a single id or a list of ids.
+func referencedIds(value interface{}) []interface{} {
+	if refs, ok := value.([]interface{}); ok {
+		return refs
+	}
+	return []interface{}{value}
+}
+
+func toId(value interface{}) (int64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return int64(v), v == float64(int64(v))
+	case int64:
+		return v, true
+	}
+	return 0, false
+}
+
+func copyObject(obj map[string]interface{}) map[string]interface{} {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		panic(err)
+	}
+	var objCopy map[string]interface{}
+	if err := json.Unmarshal(data, &objCopy); err != nil {
+		panic(err)
+	}
+	return objCopy
+}
+
+func writeDetail(w http.ResponseWriter, status int, detail string) {
+	writeJSON(w, status, map[string]interface{}{"detail": detail})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// collection holds the objects of one kind and describes how they are
+// validated and searched.
+type collection struct {
+	singular string
+	// required lists the string fields which may not be blank.
+	required []string
+	// unique lists the fields which may not hold the same value twice.
+	unique []string
+	// search lists the fields matched by the search query parameter.
+	search []string
+	// references maps fields holding ids of other objects to the collection
+	// of those objects.
+	references map[string]string
+	// defaults holds the values of fields omitted when creating an object.
+	defaults map[string]interface{}
+	// secret lists the fields served blank for objects flagged with
+	// is_secret, as Spade never returns secret values.
+	secret []string
+
+	objects map[int64]map[string]interface{}
+	lastId  int64
+}
+
+func newCollections() map[string]*collection {
+	return map[string]*collection{
+		"executors": {
+			singular: "executor",
+			required: []string{"name", "callable"},
+			unique:   []string{"name"},
+			search:   []string{"name"},
+			defaults: map[string]interface{}{
+				"description":               "",
+				"history_provider_callable": "",
+			},
+		},
+		"fileprocessors": {
+			singular: "file processor",
+			required: []string{"name", "callable"},
+			unique:   []string{"name"},
+			search:   []string{"name"},
+			defaults: map[string]interface{}{
+				"description": "",
+			},
+		},
+		"fileformats": {
+			singular: "file format",
+			required: []string{"format"},
+			unique:   []string{"format"},
+			search:   []string{"format"},
+		},
+		"processes": {
+			singular:   "process",
+			required:   []string{"code"},
+			unique:     []string{"code"},
+			search:     []string{"code", "description"},
+			references: map[string]string{"executor": "executors", "variable_sets": "variable-sets"},
+			defaults: map[string]interface{}{
+				"description":   "",
+				"tags":          []interface{}{},
+				"system_params": map[string]interface{}{},
+				"user_params":   map[string]interface{}{},
+				"variable_sets": []interface{}{},
+			},
+		},
+		"files": {
+			singular: "file",
+			required: []string{"code"},
+			unique:   []string{"code"},
+			search:   []string{"code", "description"},
+			references: map[string]string{
+				"format":         "fileformats",
+				"processor":      "fileprocessors",
+				"linked_process": "processes",
+				"variable_sets":  "variable-sets",
+			},
+			defaults: map[string]interface{}{
+				"description":    "",
+				"tags":           []interface{}{},
+				"system_params":  map[string]interface{}{},
+				"user_params":    map[string]interface{}{},
+				"linked_process": nil,
+				"variable_sets":  []interface{}{},
+			},
+		},
+		"users": {
+			singular:   "user",
+			required:   []string{"email"},
+			unique:     []string{"email"},
+			search:     []string{"email", "first_name", "last_name"},
+			references: map[string]string{"groups": "groups"},
+			defaults: map[string]interface{}{
+				"first_name": "",
+				"last_name":  "",
+				"is_active":  true,
+				"groups":     []interface{}{},
+			},
+		},
+		"groups": {
+			singular: "group",
+			required: []string{"name"},
+			unique:   []string{"name"},
+			search:   []string{"name"},
+		},
+		"variables": {
+			singular: "variable",
+			required: []string{"name"},
+			unique:   []string{"name"},
+			search:   []string{"name", "description"},
+			defaults: map[string]interface{}{
+				"description": "",
+				"value":       "",
+				"is_secret":   false,
+			},
+			secret: []string{"value"},
+		},
+		"variable-sets": {
+			singular:   "variable set",
+			required:   []string{"name"},
+			unique:     []string{"name"},
+			search:     []string{"name", "description"},
+			references: map[string]string{"variables": "variables"},
+			defaults: map[string]interface{}{
+				"description": "",
+				"variables":   []interface{}{},
+			},
+		},
+	}
+}
+
+// serve returns obj as it is served by the API, with the secret fields blank
+// if the object is flagged with is_secret.
+func (c *collection) serve(obj map[string]interface{}) map[string]interface{} {
+	if isSecret, _ := obj["is_secret"].(bool); !isSecret || len(c.secret) == 0 {
+		return obj
+	}
+	obj = copyObject(obj)
+	for _, field := range c.secret {
+		obj[field] = ""
+	}
+	return obj
+}
+
+// store saves obj, returning the object as it will be served.
+func (c *collection) store(obj map[string]interface{}) map[string]interface{} {
+	obj = copyObject(obj)
+	id, _ := toId(obj["id"])
+	if c.objects == nil {
+		c.objects = map[int64]map[string]interface{}{}
+	}
+	c.objects[id] = obj
+	return c.serve(obj)
+}
+
+func (c *collection) ids() []int64 {
+	ids := make([]int64, 0, len(c.objects))
+	for id := range c.objects {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+func (c *collection) matches(obj map[string]interface{}, search string) bool {
+	for _, field := range c.search {
+		if value, ok := obj[field].(string); ok && strings.Contains(strings.ToLower(value), search) {
+			return true
+		}
+	}
+	return false
+}